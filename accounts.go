@@ -33,9 +33,44 @@ type Account struct {
 	Moved          *Account       `json:"moved"`
 	Fields         []Field        `json:"fields"`
 	Bot            bool           `json:"bot"`
+	Group          bool           `json:"group"`
 	Discoverable   bool           `json:"discoverable"`
 	Source         *AccountSource `json:"source"`
-	FollowedTag    []FollowedTag  `json:"followed_tags"`
+	Indexable      bool           `json:"indexable"`
+	Memorial       bool           `json:"memorial"`
+	Suspended      bool           `json:"suspended"`
+	Limited        bool           `json:"limited"`
+	Roles          []Role         `json:"roles"`
+	FollowedTag    []Tag          `json:"followed_tags"`
+	// Noindex is nil when the server does not tell whether the account opts out of search engines.
+	Noindex *bool `json:"noindex"`
+	// HideCollections is nil when the server does not tell whether the follows are hidden.
+	HideCollections *bool `json:"hide_collections"`
+	// LastStatusAt is the day of the last status, or nil if the account never posted.
+	LastStatusAt *Date `json:"last_status_at"`
+	// MuteExpiresAt is set on the accounts returned by GetMutes for time-limited mutes.
+	MuteExpiresAt *time.Time `json:"mute_expires_at"`
+}
+
+// CredentialAccount is the Account of the current user with its profile
+// source and role. Account.Source is left nil in favor of Source.
+type CredentialAccount struct {
+	Account
+	Source *AccountSource `json:"source"`
+	Role   *Role          `json:"role"`
+}
+
+// account returns a as an Account with Account.Source set.
+func (a *CredentialAccount) account() *Account {
+	account := a.Account
+	account.Source = a.Source
+	return &account
+}
+
+// FamiliarFollowers holds the accounts followed by the current user which also follow an account.
+type FamiliarFollowers struct {
+	ID       ID         `json:"id"`
+	Accounts []*Account `json:"accounts"`
 }
 
 // Field is a Mastodon account profile field.
@@ -77,21 +112,43 @@ func (u UnixTimeString) MarshalJSON() ([]byte, error) {
 	return json.Marshal(strconv.FormatInt(u.Unix(), 10))
 }
 
-// History is the history of a followed tag
-type FollowedTagHistory struct {
-	Day      UnixTimeString `json:"day,omitempty"`
-	Accounts int            `json:"accounts,string,omitempty"`
-	Uses     int            `json:"uses,string,omitempty"`
+// Date represents a calendar day such as "2024-01-02".
+type Date struct {
+	time.Time
 }
 
-// FollowedTag is a Hash Tag followed by the user
-type FollowedTag struct {
-	Name      string               `json:"name,omitempty"`
-	URL       string               `json:"url,omitempty"`
-	History   []FollowedTagHistory `json:"history,omitempty"`
-	Following bool                 `json:"following,omitempty"`
+func (d *Date) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		// Some servers send a full timestamp.
+		t, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return err
+		}
+	}
+	d.Time = t
+	return nil
 }
 
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(time.DateOnly))
+}
+
+// FollowedTagHistory is the history of a followed tag.
+//
+// Deprecated: use History.
+type FollowedTagHistory = History
+
+// FollowedTag is a Hash Tag followed by the user.
+//
+// Deprecated: use Tag.
+type FollowedTag = Tag
+
 // GetAccount return Account.
 func (c *Client) GetAccount(ctx context.Context, id ID) (*Account, error) {
 	var account Account
@@ -104,7 +161,16 @@ func (c *Client) GetAccount(ctx context.Context, id ID) (*Account, error) {
 
 // GetAccountCurrentUser returns the Account of current user.
 func (c *Client) GetAccountCurrentUser(ctx context.Context) (*Account, error) {
-	var account Account
+	account, err := c.GetCredentialAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return account.account(), nil
+}
+
+// GetCredentialAccount returns the CredentialAccount of current user.
+func (c *Client) GetCredentialAccount(ctx context.Context) (*CredentialAccount, error) {
+	var account CredentialAccount
 	err := c.doAPI(ctx, http.MethodGet, "/api/v1/accounts/verify_credentials", nil, &account, nil)
 	if err != nil {
 		return nil, err
@@ -141,6 +207,16 @@ type Profile struct {
 
 // AccountUpdate updates the information of the current user.
 func (c *Client) AccountUpdate(ctx context.Context, profile *Profile) (*Account, error) {
+	account, err := c.UpdateCredentialAccount(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return account.account(), nil
+}
+
+// UpdateCredentialAccount updates the information of the current user and
+// returns its CredentialAccount.
+func (c *Client) UpdateCredentialAccount(ctx context.Context, profile *Profile) (*CredentialAccount, error) {
 	params := url.Values{}
 	if profile.DisplayName != nil {
 		params.Set("display_name", *profile.DisplayName)
@@ -175,7 +251,7 @@ func (c *Client) AccountUpdate(ctx context.Context, profile *Profile) (*Account,
 		params.Set("header", profile.Header)
 	}
 
-	var account Account
+	var account CredentialAccount
 	err := c.doAPI(ctx, http.MethodPatch, "/api/v1/accounts/update_credentials", params, &account, nil)
 	if err != nil {
 		return nil, err
@@ -284,22 +360,58 @@ func (c *Client) GetEndorsements(ctx context.Context, pg *Pagination) ([]*Accoun
 
 // Relationship holds information for relationship to the account.
 type Relationship struct {
-	ID                  ID   `json:"id"`
-	Following           bool `json:"following"`
-	FollowedBy          bool `json:"followed_by"`
-	Blocking            bool `json:"blocking"`
-	Muting              bool `json:"muting"`
-	MutingNotifications bool `json:"muting_notifications"`
-	Requested           bool `json:"requested"`
-	DomainBlocking      bool `json:"domain_blocking"`
-	ShowingReblogs      bool `json:"showing_reblogs"`
-	Endorsed            bool `json:"endorsed"`
+	ID                  ID       `json:"id"`
+	Following           bool     `json:"following"`
+	FollowedBy          bool     `json:"followed_by"`
+	Blocking            bool     `json:"blocking"`
+	Muting              bool     `json:"muting"`
+	MutingNotifications bool     `json:"muting_notifications"`
+	Requested           bool     `json:"requested"`
+	DomainBlocking      bool     `json:"domain_blocking"`
+	ShowingReblogs      bool     `json:"showing_reblogs"`
+	Endorsed            bool     `json:"endorsed"`
+	Notifying           bool     `json:"notifying"`
+	Languages           []string `json:"languages"`
+}
+
+// FollowOptions customizes a follow made by AccountFollowWithOptions.
+type FollowOptions struct {
+	// Reblogs shows the reblogs of the account on the home timeline.
+	// Nil keeps the server default.
+	Reblogs *bool
+	// Notify notifies when the account posts.
+	// Nil keeps the server default.
+	Notify *bool
+	// Languages restricts the home timeline to statuses in these ISO 639-1 languages.
+	Languages []string
+}
+
+func (o *FollowOptions) toValues() url.Values {
+	params := url.Values{}
+	if o == nil {
+		return params
+	}
+	if o.Reblogs != nil {
+		params.Set("reblogs", strconv.FormatBool(*o.Reblogs))
+	}
+	if o.Notify != nil {
+		params.Set("notify", strconv.FormatBool(*o.Notify))
+	}
+	for _, lang := range o.Languages {
+		params.Add("languages[]", lang)
+	}
+	return params
 }
 
 // AccountFollow follows the account.
 func (c *Client) AccountFollow(ctx context.Context, id ID) (*Relationship, error) {
+	return c.AccountFollowWithOptions(ctx, id, nil)
+}
+
+// AccountFollowWithOptions follows the account, or updates the options of an existing follow.
+func (c *Client) AccountFollowWithOptions(ctx context.Context, id ID, opts *FollowOptions) (*Relationship, error) {
 	var relationship Relationship
-	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/follow", url.PathEscape(string(id))), nil, &relationship, nil)
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/follow", url.PathEscape(string(id))), opts.toValues(), &relationship, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -336,10 +448,38 @@ func (c *Client) AccountUnblock(ctx context.Context, id ID) (*Relationship, erro
 	return &relationship, nil
 }
 
+// MuteOptions customizes a mute made by AccountMuteWithOptions.
+type MuteOptions struct {
+	// Notifications also mutes the notifications from the account.
+	// Nil keeps the server default, which mutes them.
+	Notifications *bool
+	// Duration limits how long the mute lasts. Zero mutes indefinitely.
+	Duration time.Duration
+}
+
+func (o *MuteOptions) toValues() url.Values {
+	params := url.Values{}
+	if o == nil {
+		return params
+	}
+	if o.Notifications != nil {
+		params.Set("notifications", strconv.FormatBool(*o.Notifications))
+	}
+	if o.Duration > 0 {
+		params.Set("duration", strconv.FormatInt(int64(o.Duration/time.Second), 10))
+	}
+	return params
+}
+
 // AccountMute mutes the account.
 func (c *Client) AccountMute(ctx context.Context, id ID) (*Relationship, error) {
+	return c.AccountMuteWithOptions(ctx, id, nil)
+}
+
+// AccountMuteWithOptions mutes the account, optionally keeping its notifications or expiring the mute.
+func (c *Client) AccountMuteWithOptions(ctx context.Context, id ID, opts *MuteOptions) (*Relationship, error) {
 	var relationship Relationship
-	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/mute", url.PathEscape(string(id))), nil, &relationship, nil)
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/mute", url.PathEscape(string(id))), opts.toValues(), &relationship, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -356,6 +496,32 @@ func (c *Client) AccountUnmute(ctx context.Context, id ID) (*Relationship, error
 	return &relationship, nil
 }
 
+// AccountRemoveFromFollowers removes the account from the followers of the current user.
+func (c *Client) AccountRemoveFromFollowers(ctx context.Context, id ID) (*Relationship, error) {
+	var relationship Relationship
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/remove_from_followers", url.PathEscape(string(id))), nil, &relationship, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &relationship, nil
+}
+
+// GetFamiliarFollowers returns, for each account of ids, the accounts
+// followed by the current user which also follow it.
+func (c *Client) GetFamiliarFollowers(ctx context.Context, ids []ID) ([]*FamiliarFollowers, error) {
+	params := url.Values{}
+	for _, id := range ids {
+		params.Add("id[]", string(id))
+	}
+
+	var familiar []*FamiliarFollowers
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/accounts/familiar_followers", params, &familiar, nil)
+	if err != nil {
+		return nil, err
+	}
+	return familiar, nil
+}
+
 // GetAccountRelationships returns relationship for the account.
 func (c *Client) GetAccountRelationships(ctx context.Context, ids []string) ([]*Relationship, error) {
 	params := url.Values{}
@@ -443,8 +609,8 @@ func (c *Client) GetMutes(ctx context.Context, pg *Pagination) ([]*Account, erro
 }
 
 // GetFollowedTags returns the list of Hashtags followed by the user.
-func (c *Client) GetFollowedTags(ctx context.Context, pg *Pagination) ([]*FollowedTag, error) {
-	var followedTags []*FollowedTag
+func (c *Client) GetFollowedTags(ctx context.Context, pg *Pagination) ([]*Tag, error) {
+	var followedTags []*Tag
 	err := c.doAPI(ctx, http.MethodGet, "/api/v1/followed_tags", nil, &followedTags, pg)
 	if err != nil {
 		return nil, err
@@ -105,6 +105,90 @@ func TestGetAccountCurrentUser(t *testing.T) {
 	}
 }
 
+func TestGetCredentialAccount(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts/verify_credentials" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"username": "zzz", "source": {"privacy": "unlisted"}, "role": {"id": "3", "name": "Owner", "permissions": "1"}}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	ca, err := client.GetCredentialAccount(context.Background())
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if ca.Username != "zzz" || ca.Source == nil || *ca.Source.Privacy != "unlisted" {
+		t.Fatalf("want %q with %q source but %q, %v", "zzz", "unlisted", ca.Username, ca.Source)
+	}
+	if ca.Role == nil || !ca.Role.HasPermission(RolePermissionAdministrator) {
+		t.Fatalf("want administrator role but %v", ca.Role)
+	}
+	a, err := client.GetAccountCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if a.Source == nil || *a.Source.Privacy != "unlisted" {
+		t.Fatalf("want %q source but %v", "unlisted", a.Source)
+	}
+}
+
+func TestAccountUnmarshalJSON(t *testing.T) {
+	var a Account
+	err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"group": true,
+		"noindex": true,
+		"memorial": true,
+		"suspended": false,
+		"limited": true,
+		"indexable": true,
+		"hide_collections": null,
+		"last_status_at": "2024-01-02",
+		"roles": [{"id": "2", "name": "Moderator", "color": "#ff0000"}]
+	}`), &a)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !a.Group || !a.Memorial || a.Suspended || !a.Limited || !a.Indexable {
+		t.Fatalf("want group, memorial, limited and indexable but %v", a)
+	}
+	if a.Noindex == nil || !*a.Noindex || a.HideCollections != nil {
+		t.Fatalf("want noindex and unknown hide_collections but %v, %v", a.Noindex, a.HideCollections)
+	}
+	if a.LastStatusAt == nil || !a.LastStatusAt.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("want %v but %v", "2024-01-02", a.LastStatusAt)
+	}
+	if len(a.Roles) != 1 || a.Roles[0].Name != "Moderator" {
+		t.Fatalf("want %q role but %v", "Moderator", a.Roles)
+	}
+
+	a = Account{}
+	if err := json.Unmarshal([]byte(`{"last_status_at": null}`), &a); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if a.LastStatusAt != nil {
+		t.Fatalf("want nil but %v", a.LastStatusAt)
+	}
+	if err := json.Unmarshal([]byte(`{"last_status_at": "yesterday"}`), &a); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	b, err := json.Marshal(Date{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if string(b) != `"2024-01-02"` {
+		t.Fatalf("want %q but %q", `"2024-01-02"`, string(b))
+	}
+}
+
 func TestAccountUpdate(t *testing.T) {
 	canErr := true
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -556,6 +640,46 @@ func TestAccountFollow(t *testing.T) {
 	}
 }
 
+func TestAccountFollowWithOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts/1234567/follow" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.PostForm["notify"]; ok || r.PostForm.Get("reblogs") != "false" || len(r.PostForm["languages[]"]) != 2 {
+			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintln(w, `{"id":1234567,"following":true,"showing_reblogs":false,"notifying":false,"languages":["en","ja"]}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	reblogs := false
+	rel, err := client.AccountFollowWithOptions(context.Background(), "1234567", &FollowOptions{
+		Reblogs:   &reblogs,
+		Languages: []string{"en", "ja"},
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if rel.ShowingReblogs || rel.Notifying {
+		t.Fatalf("want %t, %t but %t, %t", false, false, rel.ShowingReblogs, rel.Notifying)
+	}
+	if len(rel.Languages) != 2 || rel.Languages[1] != "ja" {
+		t.Fatalf("want %v but %v", []string{"en", "ja"}, rel.Languages)
+	}
+}
+
 func TestAccountUnfollow(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/accounts/1234567/unfollow" {
@@ -684,6 +808,103 @@ func TestAccountMute(t *testing.T) {
 	}
 }
 
+func TestAccountMuteWithOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts/1234567/mute" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if r.FormValue("notifications") != "false" || r.FormValue("duration") != "86400" {
+			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintln(w, `{"id":1234567,"muting":true,"muting_notifications":false}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	notifications := false
+	rel, err := client.AccountMuteWithOptions(context.Background(), "1234567", &MuteOptions{
+		Notifications: &notifications,
+		Duration:      24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !rel.Muting || rel.MutingNotifications {
+		t.Fatalf("want %t, %t but %t, %t", true, false, rel.Muting, rel.MutingNotifications)
+	}
+}
+
+func TestAccountRemoveFromFollowers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts/1234567/remove_from_followers" || r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"id":1234567,"followed_by":false}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.AccountRemoveFromFollowers(context.Background(), "123")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	rel, err := client.AccountRemoveFromFollowers(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if rel.FollowedBy {
+		t.Fatalf("want %t but %t", false, rel.FollowedBy)
+	}
+}
+
+func TestGetFamiliarFollowers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts/familiar_followers" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if len(r.URL.Query()["id[]"]) != 2 {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `[{"id": "1", "accounts": [{"id": "3", "username": "foo"}]}, {"id": "2", "accounts": []}]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	familiar, err := client.GetFamiliarFollowers(context.Background(), []ID{"1", "2"})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(familiar) != 2 {
+		t.Fatalf("result should be two: %d", len(familiar))
+	}
+	if len(familiar[0].Accounts) != 1 || familiar[0].Accounts[0].Username != "foo" {
+		t.Fatalf("want %q but %v", "foo", familiar[0].Accounts)
+	}
+	if len(familiar[1].Accounts) != 0 {
+		t.Fatalf("want no accounts but %v", familiar[1].Accounts)
+	}
+}
+
 func TestAccountUnmute(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/accounts/1234567/unmute" {
@@ -2,7 +2,9 @@ package mastodon
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -25,6 +27,127 @@ type AdminAccount struct {
 	Silenced      bool      `json:"silenced"`
 	Suspended     bool      `json:"suspended"`
 	Account       *Account  `json:"account"`
+	IPs           []AdminIP `json:"ips"`
+	InvitedByID   ID        `json:"invited_by_account_id"`
+	Role          *Role     `json:"role"`
+}
+
+// AdminIP holds an IP address used by an account.
+type AdminIP struct {
+	IP     string    `json:"ip"`
+	UsedAt time.Time `json:"used_at"`
+}
+
+// Permission bits of Role.Permissions
+const (
+	RolePermissionAdministrator       int64 = 1 << 0
+	RolePermissionViewDevops          int64 = 1 << 1
+	RolePermissionViewAuditLog        int64 = 1 << 2
+	RolePermissionViewDashboard       int64 = 1 << 3
+	RolePermissionManageReports       int64 = 1 << 4
+	RolePermissionManageFederation    int64 = 1 << 5
+	RolePermissionManageSettings      int64 = 1 << 6
+	RolePermissionManageBlocks        int64 = 1 << 7
+	RolePermissionManageTaxonomies    int64 = 1 << 8
+	RolePermissionManageAppeals       int64 = 1 << 9
+	RolePermissionManageUsers         int64 = 1 << 10
+	RolePermissionManageInvites       int64 = 1 << 11
+	RolePermissionManageRules         int64 = 1 << 12
+	RolePermissionManageAnnouncements int64 = 1 << 13
+	RolePermissionManageCustomEmojis  int64 = 1 << 14
+	RolePermissionManageWebhooks      int64 = 1 << 15
+	RolePermissionInviteUsers         int64 = 1 << 16
+	RolePermissionManageRoles         int64 = 1 << 17
+	RolePermissionManageUserAccess    int64 = 1 << 18
+	RolePermissionDeleteUserData      int64 = 1 << 19
+)
+
+// Role holds a user role which grants permissions to an account.
+type Role struct {
+	ID          ID     `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Permissions int64  `json:"permissions,string"`
+	Highlighted bool   `json:"highlighted"`
+}
+
+// HasPermission reports whether the role grants permission.
+// The administrator permission grants every permission.
+func (r *Role) HasPermission(permission int64) bool {
+	if r.Permissions&RolePermissionAdministrator != 0 {
+		return true
+	}
+	return r.Permissions&permission == permission
+}
+
+// Convenience constants for AccountWarning.Action and AdminAccountAction.Type
+const (
+	AccountWarningActionNone                    = "none"
+	AccountWarningActionDisable                 = "disable"
+	AccountWarningActionMarkStatusesAsSensitive = "mark_statuses_as_sensitive"
+	AccountWarningActionDeleteStatuses          = "delete_statuses"
+	AccountWarningActionSensitive               = "sensitive"
+	AccountWarningActionSilence                 = "silence"
+	AccountWarningActionSuspend                 = "suspend"
+)
+
+// Convenience constants for Appeal.State
+const (
+	AppealStateApproved = "approved"
+	AppealStateRejected = "rejected"
+	AppealStatePending  = "pending"
+)
+
+// AccountWarning holds a moderation warning against an account.
+type AccountWarning struct {
+	ID            ID        `json:"id"`
+	Action        string    `json:"action"`
+	Text          string    `json:"text"`
+	StatusIDs     []ID      `json:"status_ids"`
+	TargetAccount *Account  `json:"target_account"`
+	Appeal        *Appeal   `json:"appeal"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Appeal holds the appeal submitted against a moderation warning.
+type Appeal struct {
+	Text  string `json:"text"`
+	State string `json:"state"`
+}
+
+// Convenience constants for AdminAccountsFilter.Origin
+const (
+	AdminAccountOriginLocal  = "local"
+	AdminAccountOriginRemote = "remote"
+)
+
+// Convenience constants for AdminAccountsFilter.Status
+const (
+	AdminAccountStatusActive    = "active"
+	AdminAccountStatusPending   = "pending"
+	AdminAccountStatusDisabled  = "disabled"
+	AdminAccountStatusSilenced  = "silenced"
+	AdminAccountStatusSuspended = "suspended"
+)
+
+// AdminAccountsFilter customizes which accounts are returned by GetAdminAccountsFilter.
+// See:
+//
+//	https://docs.joinmastodon.org/methods/admin/accounts/#v2
+type AdminAccountsFilter struct {
+	// Origin is one of local and remote.
+	Origin string
+	// Status is one of active, pending, disabled, silenced and suspended.
+	Status string
+	// Permissions filters the accounts with staff permissions when set to staff.
+	Permissions string
+	RoleIDs     []ID
+	InvitedBy   ID
+	Username    string
+	DisplayName string
+	ByDomain    string
+	Email       string
+	IP          string
 }
 
 // AdminReport holds the admin-level view of a report.
@@ -42,6 +165,40 @@ type AdminReport struct {
 	AssignedAccount      *AdminAccount `json:"assigned_account"`
 	ActionTakenByAccount *AdminAccount `json:"action_taken_by_account"`
 	Statuses             []*Status     `json:"statuses"`
+	StatusIDs            []ID          `json:"status_ids"`
+	Rules                []Rule        `json:"rules"`
+	RuleIDs              []ID          `json:"rule_ids"`
+}
+
+// AdminReportsFilter customizes which reports are returned by GetAdminReportsFilter.
+type AdminReportsFilter struct {
+	// Resolved returns the resolved reports instead of the unresolved ones.
+	Resolved        bool
+	AccountID       ID
+	TargetAccountID ID
+}
+
+// Convenience constants for AdminIPBlock.Severity
+const (
+	IPBlockSeveritySignUpRequiresApproval = "sign_up_requires_approval"
+	IPBlockSeveritySignUpBlock            = "sign_up_block"
+	IPBlockSeverityNoAccess               = "no_access"
+)
+
+// AdminIPBlock holds information for a blocked IP range.
+type AdminIPBlock struct {
+	ID        ID         `json:"id"`
+	IP        string     `json:"ip"`
+	Severity  string     `json:"severity"`
+	Comment   string     `json:"comment"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// AdminCanonicalEmailBlock holds information for a blocked canonical e-mail address.
+type AdminCanonicalEmailBlock struct {
+	ID                 ID     `json:"id"`
+	CanonicalEmailHash string `json:"canonical_email_hash"`
 }
 
 // AdminAccountAction specifies a moderation action against an account.
@@ -64,6 +221,77 @@ func (c *Client) GetAdminAccounts(ctx context.Context, pg *Pagination) ([]*Admin
 	return accounts, nil
 }
 
+// AdminAccounts iterates over the accounts matching filter from the admin view.
+func (c *Client) AdminAccounts(ctx context.Context, filter *AdminAccountsFilter, pg *Pagination) iter.Seq2[*AdminAccount, error] {
+	return func(yield func(*AdminAccount, error) bool) {
+		var zero Pagination
+		if pg == nil {
+			pg = &Pagination{}
+		}
+		for {
+			vs, err := c.GetAdminAccountsFilter(ctx, filter, pg)
+			if err != nil {
+				_ = yield(nil, err)
+				return
+			}
+
+			for _, v := range vs {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if *pg == zero {
+				return
+			}
+		}
+	}
+}
+
+// GetAdminAccountsFilter returns the accounts matching filter from the admin view.
+func (c *Client) GetAdminAccountsFilter(ctx context.Context, filter *AdminAccountsFilter, pg *Pagination) ([]*AdminAccount, error) {
+	params := url.Values{}
+	if filter != nil {
+		if filter.Origin != "" {
+			params.Set("origin", filter.Origin)
+		}
+		if filter.Status != "" {
+			params.Set("status", filter.Status)
+		}
+		if filter.Permissions != "" {
+			params.Set("permissions", filter.Permissions)
+		}
+		for _, id := range filter.RoleIDs {
+			params.Add("role_ids[]", string(id))
+		}
+		if filter.InvitedBy != "" {
+			params.Set("invited_by", string(filter.InvitedBy))
+		}
+		if filter.Username != "" {
+			params.Set("username", filter.Username)
+		}
+		if filter.DisplayName != "" {
+			params.Set("display_name", filter.DisplayName)
+		}
+		if filter.ByDomain != "" {
+			params.Set("by_domain", filter.ByDomain)
+		}
+		if filter.Email != "" {
+			params.Set("email", filter.Email)
+		}
+		if filter.IP != "" {
+			params.Set("ip", filter.IP)
+		}
+	}
+
+	var accounts []*AdminAccount
+	err := c.doAPI(ctx, http.MethodGet, "/api/v2/admin/accounts", params, &accounts, pg)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
 // GetAdminAccount returns an account from the admin view.
 func (c *Client) GetAdminAccount(ctx context.Context, id ID) (*AdminAccount, error) {
 	var account AdminAccount
@@ -93,6 +321,16 @@ func (c *Client) AdminAccountPerformAction(ctx context.Context, id ID, action *A
 	return c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/admin/accounts/%s/action", url.PathEscape(string(id))), params, nil, nil)
 }
 
+// DeleteAdminAccount permanently deletes the data of the suspended account.
+func (c *Client) DeleteAdminAccount(ctx context.Context, id ID) (*AdminAccount, error) {
+	var account AdminAccount
+	err := c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/accounts/%s", url.PathEscape(string(id))), nil, &account, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
 // AdminAccountApprove approves the pending account.
 func (c *Client) AdminAccountApprove(ctx context.Context, id ID) (*AdminAccount, error) {
 	return c.adminAccountPost(ctx, id, "approve")
@@ -142,6 +380,46 @@ func (c *Client) GetAdminReports(ctx context.Context, pg *Pagination) ([]*AdminR
 	return reports, nil
 }
 
+// GetAdminReportsFilter returns the reports matching filter from the admin view.
+func (c *Client) GetAdminReportsFilter(ctx context.Context, filter *AdminReportsFilter, pg *Pagination) ([]*AdminReport, error) {
+	params := url.Values{}
+	if filter != nil {
+		if filter.Resolved {
+			params.Set("resolved", "true")
+		}
+		if filter.AccountID != "" {
+			params.Set("account_id", string(filter.AccountID))
+		}
+		if filter.TargetAccountID != "" {
+			params.Set("target_account_id", string(filter.TargetAccountID))
+		}
+	}
+
+	var reports []*AdminReport
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/reports", params, &reports, pg)
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// UpdateAdminReport changes the category of the report of id.
+// ruleIDs are the violated rules and only apply to the violation category.
+func (c *Client) UpdateAdminReport(ctx context.Context, id ID, category string, ruleIDs []ID) (*AdminReport, error) {
+	params := url.Values{}
+	params.Set("category", category)
+	for _, ruleID := range ruleIDs {
+		params.Add("rule_ids[]", string(ruleID))
+	}
+
+	var report AdminReport
+	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/admin/reports/%s", url.PathEscape(string(id))), params, &report, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
 // GetAdminReport returns a report from the admin view.
 func (c *Client) GetAdminReport(ctx context.Context, id ID) (*AdminReport, error) {
 	var report AdminReport
@@ -180,3 +458,143 @@ func (c *Client) adminReportPost(ctx context.Context, id ID, action string) (*Ad
 	}
 	return &report, nil
 }
+
+// GetAdminIPBlocks returns the blocked IP ranges.
+func (c *Client) GetAdminIPBlocks(ctx context.Context, pg *Pagination) ([]*AdminIPBlock, error) {
+	var blocks []*AdminIPBlock
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/ip_blocks", nil, &blocks, pg)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// GetAdminIPBlock returns the blocked IP range of id.
+func (c *Client) GetAdminIPBlock(ctx context.Context, id ID) (*AdminIPBlock, error) {
+	var block AdminIPBlock
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/ip_blocks/%s", url.PathEscape(string(id))), nil, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// CreateAdminIPBlock blocks the IP range block.IP, a single address or a CIDR.
+// If block.ExpiresAt is nil, the block never expires.
+func (c *Client) CreateAdminIPBlock(ctx context.Context, block *AdminIPBlock) (*AdminIPBlock, error) {
+	if block == nil {
+		return nil, errors.New("block can't be nil")
+	}
+	if block.IP == "" {
+		return nil, errors.New("ip can't be empty")
+	}
+	if block.Severity == "" {
+		return nil, errors.New("severity can't be empty")
+	}
+
+	var b AdminIPBlock
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/ip_blocks", block.toValues(), &b, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// UpdateAdminIPBlock replaces the blocked IP range of id with block.
+func (c *Client) UpdateAdminIPBlock(ctx context.Context, id ID, block *AdminIPBlock) (*AdminIPBlock, error) {
+	if block == nil {
+		return nil, errors.New("block can't be nil")
+	}
+	if id == ID("") {
+		return nil, errors.New("ID can't be empty")
+	}
+
+	var b AdminIPBlock
+	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/admin/ip_blocks/%s", url.PathEscape(string(id))), block.toValues(), &b, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// DeleteAdminIPBlock unblocks the blocked IP range of id.
+func (c *Client) DeleteAdminIPBlock(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/ip_blocks/%s", url.PathEscape(string(id))), nil, nil, nil)
+}
+
+func (b *AdminIPBlock) toValues() url.Values {
+	params := url.Values{}
+	if b.IP != "" {
+		params.Set("ip", b.IP)
+	}
+	if b.Severity != "" {
+		params.Set("severity", b.Severity)
+	}
+	params.Set("comment", b.Comment)
+	if b.ExpiresAt != nil {
+		diff := time.Until(*b.ExpiresAt)
+		params.Set("expires_in", fmt.Sprintf("%.0f", diff.Seconds()))
+	}
+	return params
+}
+
+// GetAdminCanonicalEmailBlocks returns the blocked canonical e-mail addresses.
+func (c *Client) GetAdminCanonicalEmailBlocks(ctx context.Context, pg *Pagination) ([]*AdminCanonicalEmailBlock, error) {
+	var blocks []*AdminCanonicalEmailBlock
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/canonical_email_blocks", nil, &blocks, pg)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// GetAdminCanonicalEmailBlock returns the blocked canonical e-mail address of id.
+func (c *Client) GetAdminCanonicalEmailBlock(ctx context.Context, id ID) (*AdminCanonicalEmailBlock, error) {
+	var block AdminCanonicalEmailBlock
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/canonical_email_blocks/%s", url.PathEscape(string(id))), nil, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// TestAdminCanonicalEmailBlocks returns the blocks matching the canonical form of email.
+func (c *Client) TestAdminCanonicalEmailBlocks(ctx context.Context, email string) ([]*AdminCanonicalEmailBlock, error) {
+	params := url.Values{}
+	params.Set("email", email)
+
+	var blocks []*AdminCanonicalEmailBlock
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/canonical_email_blocks/test", params, &blocks, nil)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// CreateAdminCanonicalEmailBlock blocks the canonical form of email.
+func (c *Client) CreateAdminCanonicalEmailBlock(ctx context.Context, email string) (*AdminCanonicalEmailBlock, error) {
+	params := url.Values{}
+	params.Set("email", email)
+	return c.createAdminCanonicalEmailBlock(ctx, params)
+}
+
+// CreateAdminCanonicalEmailBlockHash blocks the canonical e-mail address of the SHA256 hash.
+func (c *Client) CreateAdminCanonicalEmailBlockHash(ctx context.Context, hash string) (*AdminCanonicalEmailBlock, error) {
+	params := url.Values{}
+	params.Set("canonical_email_hash", hash)
+	return c.createAdminCanonicalEmailBlock(ctx, params)
+}
+
+func (c *Client) createAdminCanonicalEmailBlock(ctx context.Context, params url.Values) (*AdminCanonicalEmailBlock, error) {
+	var block AdminCanonicalEmailBlock
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/canonical_email_blocks", params, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// DeleteAdminCanonicalEmailBlock unblocks the blocked canonical e-mail address of id.
+func (c *Client) DeleteAdminCanonicalEmailBlock(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/canonical_email_blocks/%s", url.PathEscape(string(id))), nil, nil, nil)
+}
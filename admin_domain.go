@@ -0,0 +1,184 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Convenience constants for AdminDomainBlock.Severity
+const (
+	DomainBlockSeverityNoop    = "noop"
+	DomainBlockSeveritySilence = "silence"
+	DomainBlockSeveritySuspend = "suspend"
+)
+
+// AdminDomainBlock holds information for a domain blocked from federating.
+type AdminDomainBlock struct {
+	ID             ID        `json:"id"`
+	Domain         string    `json:"domain"`
+	Digest         string    `json:"digest"`
+	CreatedAt      time.Time `json:"created_at"`
+	Severity       string    `json:"severity"`
+	RejectMedia    bool      `json:"reject_media"`
+	RejectReports  bool      `json:"reject_reports"`
+	PrivateComment string    `json:"private_comment"`
+	PublicComment  string    `json:"public_comment"`
+	Obfuscate      bool      `json:"obfuscate"`
+}
+
+// AdminDomainAllow holds information for a domain allowed to federate.
+type AdminDomainAllow struct {
+	ID        ID        `json:"id"`
+	Domain    string    `json:"domain"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AdminEmailDomainBlock holds information for an e-mail domain blocked from signing up.
+type AdminEmailDomainBlock struct {
+	ID        ID        `json:"id"`
+	Domain    string    `json:"domain"`
+	CreatedAt time.Time `json:"created_at"`
+	History   []History `json:"history"`
+}
+
+// GetAdminDomainBlocks returns the blocked domains.
+func (c *Client) GetAdminDomainBlocks(ctx context.Context, pg *Pagination) ([]*AdminDomainBlock, error) {
+	var blocks []*AdminDomainBlock
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/domain_blocks", nil, &blocks, pg)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// GetAdminDomainBlock returns the blocked domain of id.
+func (c *Client) GetAdminDomainBlock(ctx context.Context, id ID) (*AdminDomainBlock, error) {
+	var block AdminDomainBlock
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/domain_blocks/%s", url.PathEscape(string(id))), nil, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// CreateAdminDomainBlock blocks block.Domain with the options of block.
+func (c *Client) CreateAdminDomainBlock(ctx context.Context, block *AdminDomainBlock) (*AdminDomainBlock, error) {
+	params := block.toValues()
+	params.Set("domain", block.Domain)
+
+	var b AdminDomainBlock
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/domain_blocks", params, &b, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// UpdateAdminDomainBlock replaces the options of the blocked domain of id with
+// the ones of block. block.Domain is ignored.
+func (c *Client) UpdateAdminDomainBlock(ctx context.Context, id ID, block *AdminDomainBlock) (*AdminDomainBlock, error) {
+	var b AdminDomainBlock
+	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/admin/domain_blocks/%s", url.PathEscape(string(id))), block.toValues(), &b, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// DeleteAdminDomainBlock unblocks the blocked domain of id.
+func (c *Client) DeleteAdminDomainBlock(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/domain_blocks/%s", url.PathEscape(string(id))), nil, nil, nil)
+}
+
+func (b *AdminDomainBlock) toValues() url.Values {
+	params := url.Values{}
+	if b.Severity != "" {
+		params.Set("severity", b.Severity)
+	}
+	params.Set("reject_media", strconv.FormatBool(b.RejectMedia))
+	params.Set("reject_reports", strconv.FormatBool(b.RejectReports))
+	params.Set("private_comment", b.PrivateComment)
+	params.Set("public_comment", b.PublicComment)
+	params.Set("obfuscate", strconv.FormatBool(b.Obfuscate))
+	return params
+}
+
+// GetAdminDomainAllows returns the domains allowed to federate.
+func (c *Client) GetAdminDomainAllows(ctx context.Context, pg *Pagination) ([]*AdminDomainAllow, error) {
+	var allows []*AdminDomainAllow
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/domain_allows", nil, &allows, pg)
+	if err != nil {
+		return nil, err
+	}
+	return allows, nil
+}
+
+// GetAdminDomainAllow returns the allowed domain of id.
+func (c *Client) GetAdminDomainAllow(ctx context.Context, id ID) (*AdminDomainAllow, error) {
+	var allow AdminDomainAllow
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/domain_allows/%s", url.PathEscape(string(id))), nil, &allow, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &allow, nil
+}
+
+// CreateAdminDomainAllow allows domain to federate.
+func (c *Client) CreateAdminDomainAllow(ctx context.Context, domain string) (*AdminDomainAllow, error) {
+	params := url.Values{}
+	params.Set("domain", domain)
+
+	var allow AdminDomainAllow
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/domain_allows", params, &allow, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &allow, nil
+}
+
+// DeleteAdminDomainAllow removes the allowed domain of id.
+func (c *Client) DeleteAdminDomainAllow(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/domain_allows/%s", url.PathEscape(string(id))), nil, nil, nil)
+}
+
+// GetAdminEmailDomainBlocks returns the blocked e-mail domains.
+func (c *Client) GetAdminEmailDomainBlocks(ctx context.Context, pg *Pagination) ([]*AdminEmailDomainBlock, error) {
+	var blocks []*AdminEmailDomainBlock
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/email_domain_blocks", nil, &blocks, pg)
+	if err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// GetAdminEmailDomainBlock returns the blocked e-mail domain of id.
+func (c *Client) GetAdminEmailDomainBlock(ctx context.Context, id ID) (*AdminEmailDomainBlock, error) {
+	var block AdminEmailDomainBlock
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/email_domain_blocks/%s", url.PathEscape(string(id))), nil, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// CreateAdminEmailDomainBlock blocks sign-ups from e-mail addresses of domain.
+func (c *Client) CreateAdminEmailDomainBlock(ctx context.Context, domain string) (*AdminEmailDomainBlock, error) {
+	params := url.Values{}
+	params.Set("domain", domain)
+
+	var block AdminEmailDomainBlock
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/email_domain_blocks", params, &block, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// DeleteAdminEmailDomainBlock unblocks the blocked e-mail domain of id.
+func (c *Client) DeleteAdminEmailDomainBlock(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/admin/email_domain_blocks/%s", url.PathEscape(string(id))), nil, nil, nil)
+}
@@ -0,0 +1,207 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAdminDomainBlocks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/admin/domain_blocks":
+			fmt.Fprintln(w, `[{"id": "1", "domain": "example.com", "severity": "suspend", "reject_media": true}, {"id": "2", "domain": "example.org", "severity": "silence"}]`)
+		case "/api/v1/admin/domain_blocks/1":
+			fmt.Fprintln(w, `{"id": "1", "domain": "example.com", "severity": "suspend", "obfuscate": true}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	blocks, err := client.GetAdminDomainBlocks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("result should be two: %d", len(blocks))
+	}
+	if blocks[0].Severity != DomainBlockSeveritySuspend || !blocks[0].RejectMedia {
+		t.Fatalf("want %q with reject_media but %q, %v", DomainBlockSeveritySuspend, blocks[0].Severity, blocks[0].RejectMedia)
+	}
+	_, err = client.GetAdminDomainBlock(context.Background(), "2")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	block, err := client.GetAdminDomainBlock(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !block.Obfuscate {
+		t.Fatalf("want %v but %v", true, block.Obfuscate)
+	}
+}
+
+func TestCreateUpdateDeleteAdminDomainBlock(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/domain_blocks":
+			if r.PostForm.Get("domain") != "example.com" || r.PostForm.Get("severity") != "silence" || r.PostForm.Get("reject_media") != "true" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "1", "domain": "example.com", "severity": "silence", "reject_media": true}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/admin/domain_blocks/1":
+			if _, ok := r.PostForm["domain"]; ok || r.PostForm.Get("reject_media") != "false" || r.PostForm.Get("public_comment") != "spam" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "1", "domain": "example.com", "severity": "suspend", "public_comment": "spam"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/domain_blocks/1":
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	block, err := client.CreateAdminDomainBlock(context.Background(), &AdminDomainBlock{
+		Domain:      "example.com",
+		Severity:    DomainBlockSeveritySilence,
+		RejectMedia: true,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.ID != "1" {
+		t.Fatalf("want %q but %q", "1", block.ID)
+	}
+	block, err = client.UpdateAdminDomainBlock(context.Background(), "1", &AdminDomainBlock{
+		Domain:        "ignored.example.com",
+		Severity:      DomainBlockSeveritySuspend,
+		PublicComment: "spam",
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.Severity != DomainBlockSeveritySuspend {
+		t.Fatalf("want %q but %q", DomainBlockSeveritySuspend, block.Severity)
+	}
+	if err := client.DeleteAdminDomainBlock(context.Background(), "1"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DeleteAdminDomainBlock(context.Background(), "2"); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
+
+func TestAdminDomainAllows(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/domain_allows":
+			fmt.Fprintln(w, `[{"id": "1", "domain": "example.com", "created_at": "2022-09-14T21:23:02.755Z"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/domain_allows/1":
+			fmt.Fprintln(w, `{"id": "1", "domain": "example.com"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/domain_allows":
+			fmt.Fprintf(w, `{"id": "2", "domain": %q}`, r.FormValue("domain"))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/domain_allows/2":
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	allows, err := client.GetAdminDomainAllows(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(allows) != 1 || allows[0].CreatedAt.IsZero() {
+		t.Fatalf("want one allowed domain with created_at but %v", allows)
+	}
+	allow, err := client.GetAdminDomainAllow(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if allow.Domain != "example.com" {
+		t.Fatalf("want %q but %q", "example.com", allow.Domain)
+	}
+	allow, err = client.CreateAdminDomainAllow(context.Background(), "example.org")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if allow.Domain != "example.org" {
+		t.Fatalf("want %q but %q", "example.org", allow.Domain)
+	}
+	if err := client.DeleteAdminDomainAllow(context.Background(), allow.ID); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
+
+func TestAdminEmailDomainBlocks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/email_domain_blocks":
+			fmt.Fprintln(w, `[{"id": "1", "domain": "example.com", "history": [{"day": "1668556800", "accounts": "2", "uses": "5"}]}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/email_domain_blocks/1":
+			fmt.Fprintln(w, `{"id": "1", "domain": "example.com"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/email_domain_blocks":
+			fmt.Fprintf(w, `{"id": "2", "domain": %q}`, r.FormValue("domain"))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/email_domain_blocks/2":
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	blocks, err := client.GetAdminEmailDomainBlocks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].History) != 1 {
+		t.Fatalf("want one blocked domain with history but %v", blocks)
+	}
+	if blocks[0].History[0].Uses != 5 {
+		t.Fatalf("want %v but %v", 5, blocks[0].History[0].Uses)
+	}
+	block, err := client.GetAdminEmailDomainBlock(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.Domain != "example.com" {
+		t.Fatalf("want %q but %q", "example.com", block.Domain)
+	}
+	block, err = client.CreateAdminEmailDomainBlock(context.Background(), "example.org")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.Domain != "example.org" {
+		t.Fatalf("want %q but %q", "example.org", block.Domain)
+	}
+	if err := client.DeleteAdminEmailDomainBlock(context.Background(), block.ID); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
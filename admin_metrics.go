@@ -0,0 +1,160 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Keys of the quantitative measures for AdminMetricsQuery.Keys
+const (
+	AdminMeasureActiveUsers              = "active_users"
+	AdminMeasureNewUsers                 = "new_users"
+	AdminMeasureInteractions             = "interactions"
+	AdminMeasureOpenedReports            = "opened_reports"
+	AdminMeasureResolvedReports          = "resolved_reports"
+	AdminMeasureTagAccounts              = "tag_accounts"
+	AdminMeasureTagUses                  = "tag_uses"
+	AdminMeasureTagServers               = "tag_servers"
+	AdminMeasureInstanceAccounts         = "instance_accounts"
+	AdminMeasureInstanceMediaAttachments = "instance_media_attachments"
+	AdminMeasureInstanceReports          = "instance_reports"
+	AdminMeasureInstanceStatuses         = "instance_statuses"
+	AdminMeasureInstanceFollows          = "instance_follows"
+	AdminMeasureInstanceFollowers        = "instance_followers"
+)
+
+// Keys of the qualitative dimensions for AdminMetricsQuery.Keys
+const (
+	AdminDimensionLanguages         = "languages"
+	AdminDimensionSources           = "sources"
+	AdminDimensionServers           = "servers"
+	AdminDimensionSpaceUsage        = "space_usage"
+	AdminDimensionSoftwareVersions  = "software_versions"
+	AdminDimensionTagServers        = "tag_servers"
+	AdminDimensionTagLanguages      = "tag_languages"
+	AdminDimensionInstanceAccounts  = "instance_accounts"
+	AdminDimensionInstanceLanguages = "instance_languages"
+)
+
+// Convenience constants for the frequency of GetAdminRetention
+const (
+	AdminRetentionDay   = "day"
+	AdminRetentionMonth = "month"
+)
+
+// AdminMetricsQuery specifies the metrics requested from GetAdminMeasures and GetAdminDimensions.
+type AdminMetricsQuery struct {
+	Keys    []string
+	StartAt time.Time
+	EndAt   time.Time
+	// TagID is the hashtag of the tag_* keys.
+	TagID ID
+	// Domain is the remote domain of the instance_* keys.
+	Domain string
+	// Limit is the maximum number of results for each dimension.
+	Limit int64
+}
+
+func (q *AdminMetricsQuery) toValues() url.Values {
+	params := url.Values{}
+	for _, key := range q.Keys {
+		params.Add("keys[]", key)
+		switch {
+		case strings.HasPrefix(key, "tag_") && q.TagID != "":
+			params.Set(key+"[id]", string(q.TagID))
+		case strings.HasPrefix(key, "instance_") && q.Domain != "":
+			params.Set(key+"[domain]", q.Domain)
+		}
+	}
+	params.Set("start_at", q.StartAt.Format(time.DateOnly))
+	params.Set("end_at", q.EndAt.Format(time.DateOnly))
+	if q.Limit > 0 {
+		params.Set("limit", fmt.Sprint(q.Limit))
+	}
+	return params
+}
+
+// AdminMeasure holds a quantitative metric over a time range.
+type AdminMeasure struct {
+	Key           string              `json:"key"`
+	Unit          string              `json:"unit"`
+	Total         int64               `json:"total,string"`
+	HumanValue    string              `json:"human_value"`
+	PreviousTotal int64               `json:"previous_total,string"`
+	Data          []AdminMeasureValue `json:"data"`
+}
+
+// AdminMeasureValue holds the value of a measure on a day.
+type AdminMeasureValue struct {
+	Date  time.Time `json:"date"`
+	Value int64     `json:"value,string"`
+}
+
+// AdminDimension holds a qualitative metric over a time range.
+type AdminDimension struct {
+	Key  string                `json:"key"`
+	Data []AdminDimensionValue `json:"data"`
+}
+
+// AdminDimensionValue holds a value of a dimension.
+type AdminDimensionValue struct {
+	Key        string `json:"key"`
+	HumanKey   string `json:"human_key"`
+	Value      string `json:"value"`
+	Unit       string `json:"unit"`
+	HumanValue string `json:"human_value"`
+}
+
+// AdminCohort holds the retention of the users who signed up in a period.
+type AdminCohort struct {
+	Period    time.Time          `json:"period"`
+	Frequency string             `json:"frequency"`
+	Data      []AdminCohortValue `json:"data"`
+}
+
+// AdminCohortValue holds the users of a cohort still active on a date.
+type AdminCohortValue struct {
+	Date  time.Time `json:"date"`
+	Rate  float64   `json:"rate"`
+	Value int64     `json:"value,string"`
+}
+
+// GetAdminMeasures returns the quantitative measures of query.Keys.
+func (c *Client) GetAdminMeasures(ctx context.Context, query *AdminMetricsQuery) ([]*AdminMeasure, error) {
+	var measures []*AdminMeasure
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/measures", query.toValues(), &measures, nil)
+	if err != nil {
+		return nil, err
+	}
+	return measures, nil
+}
+
+// GetAdminDimensions returns the qualitative dimensions of query.Keys.
+func (c *Client) GetAdminDimensions(ctx context.Context, query *AdminMetricsQuery) ([]*AdminDimension, error) {
+	var dimensions []*AdminDimension
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/dimensions", query.toValues(), &dimensions, nil)
+	if err != nil {
+		return nil, err
+	}
+	return dimensions, nil
+}
+
+// GetAdminRetention returns the retention of the users who signed up between
+// startAt and endAt, grouped by frequency which is one of day and month.
+func (c *Client) GetAdminRetention(ctx context.Context, startAt, endAt time.Time, frequency string) ([]*AdminCohort, error) {
+	params := url.Values{}
+	params.Set("start_at", startAt.Format(time.DateOnly))
+	params.Set("end_at", endAt.Format(time.DateOnly))
+	params.Set("frequency", frequency)
+
+	var cohorts []*AdminCohort
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/admin/retention", params, &cohorts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return cohorts, nil
+}
@@ -0,0 +1,128 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetAdminMeasures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/measures" || r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(r.PostForm["keys[]"]) != 2 || r.PostForm.Get("tag_uses[id]") != "123" || r.PostForm.Get("start_at") != "2022-09-01" || r.PostForm.Get("end_at") != "2022-09-08" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `[
+			{"key": "active_users", "unit": null, "total": "2", "previous_total": "0", "data": [{"date": "2022-09-01T00:00:00Z", "value": "2"}]},
+			{"key": "tag_uses", "unit": null, "total": "5", "previous_total": "1", "data": []}
+		]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	measures, err := client.GetAdminMeasures(context.Background(), &AdminMetricsQuery{
+		Keys:    []string{AdminMeasureActiveUsers, AdminMeasureTagUses},
+		StartAt: time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndAt:   time.Date(2022, 9, 8, 0, 0, 0, 0, time.UTC),
+		TagID:   "123",
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(measures) != 2 {
+		t.Fatalf("result should be two: %d", len(measures))
+	}
+	if measures[0].Total != 2 || measures[1].PreviousTotal != 1 {
+		t.Fatalf("want totals %d, %d but %d, %d", 2, 1, measures[0].Total, measures[1].PreviousTotal)
+	}
+	if len(measures[0].Data) != 1 || measures[0].Data[0].Value != 2 {
+		t.Fatalf("want one value of %d but %v", 2, measures[0].Data)
+	}
+}
+
+func TestGetAdminDimensions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/dimensions" || r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("instance_languages[domain]") != "example.com" || r.PostForm.Get("limit") != "3" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `[{"key": "instance_languages", "data": [{"key": "en", "human_key": "English", "value": "10"}]}]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	dimensions, err := client.GetAdminDimensions(context.Background(), &AdminMetricsQuery{
+		Keys:    []string{AdminDimensionInstanceLanguages},
+		StartAt: time.Now().AddDate(0, 0, -7),
+		EndAt:   time.Now(),
+		Domain:  "example.com",
+		Limit:   3,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(dimensions) != 1 || len(dimensions[0].Data) != 1 {
+		t.Fatalf("want one dimension with one value but %v", dimensions)
+	}
+	if dimensions[0].Data[0].HumanKey != "English" {
+		t.Fatalf("want %q but %q", "English", dimensions[0].Data[0].HumanKey)
+	}
+}
+
+func TestGetAdminRetention(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/retention" || r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if r.FormValue("frequency") != "month" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `[{"period": "2022-09-01T00:00:00+00:00", "frequency": "month", "data": [{"date": "2022-09-01T00:00:00+00:00", "rate": 0.5, "value": "2"}]}]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	cohorts, err := client.GetAdminRetention(context.Background(), time.Now().AddDate(0, -1, 0), time.Now(), AdminRetentionMonth)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(cohorts) != 1 || len(cohorts[0].Data) != 1 {
+		t.Fatalf("want one cohort with one value but %v", cohorts)
+	}
+	if cohorts[0].Data[0].Rate != 0.5 || cohorts[0].Data[0].Value != 2 {
+		t.Fatalf("want %v, %d but %v, %d", 0.5, 2, cohorts[0].Data[0].Rate, cohorts[0].Data[0].Value)
+	}
+	if _, err := client.GetAdminRetention(context.Background(), time.Now(), time.Now(), AdminRetentionDay); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
@@ -2,10 +2,12 @@ package mastodon
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestGetAdminAccounts(t *testing.T) {
@@ -40,6 +42,63 @@ func TestGetAdminAccounts(t *testing.T) {
 	}
 }
 
+func TestAdminAccountsFilter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/admin/accounts" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("origin") != "local" || q.Get("status") != "pending" || q.Get("by_domain") != "example.com" || len(q["role_ids[]"]) != 2 {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		switch q.Get("max_id") {
+		case "":
+			w.Header().Set("Link", `<http://example.com?max_id=2>; rel="next"`)
+			fmt.Fprintln(w, `[{"id": "3", "username": "foo", "ips": [{"ip": "192.0.2.1", "used_at": "2022-09-08T22:48:07.985Z"}]}, {"id": "2", "username": "bar"}]`)
+		case "2":
+			fmt.Fprintln(w, `[{"id": "1", "username": "baz", "invited_by_account_id": "3"}]`)
+		default:
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	filter := &AdminAccountsFilter{
+		Origin:   AdminAccountOriginLocal,
+		Status:   AdminAccountStatusPending,
+		RoleIDs:  []ID{"1", "2"},
+		ByDomain: "example.com",
+	}
+	var accounts []*AdminAccount
+	for account, err := range client.AdminAccounts(context.Background(), filter, nil) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if len(accounts) != 3 {
+		t.Fatalf("result should be three: %d", len(accounts))
+	}
+	if len(accounts[0].IPs) != 1 || accounts[0].IPs[0].IP != "192.0.2.1" {
+		t.Fatalf("want ip %q but %v", "192.0.2.1", accounts[0].IPs)
+	}
+	if accounts[2].InvitedByID != "3" {
+		t.Fatalf("want %q but %q", "3", accounts[2].InvitedByID)
+	}
+
+	for _, err := range client.AdminAccounts(context.Background(), nil, nil) {
+		if err == nil {
+			t.Fatalf("should be fail: %v", err)
+		}
+	}
+}
+
 func TestGetAdminAccount(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/admin/accounts/1234567" {
@@ -70,6 +129,74 @@ func TestGetAdminAccount(t *testing.T) {
 	}
 }
 
+func TestDeleteAdminAccount(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/accounts/1234567" || r.Method != http.MethodDelete {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"id": "1234567", "username": "foo", "suspended": true, "role": {"id": "3", "name": "Owner", "permissions": "1", "highlighted": true}}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	_, err := client.DeleteAdminAccount(context.Background(), "123")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	account, err := client.DeleteAdminAccount(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !account.Suspended {
+		t.Fatalf("want %v but %v", true, account.Suspended)
+	}
+	if account.Role == nil || account.Role.Name != "Owner" {
+		t.Fatalf("want role %q but %v", "Owner", account.Role)
+	}
+	if !account.Role.HasPermission(RolePermissionDeleteUserData) {
+		t.Fatalf("want administrator to have every permission")
+	}
+}
+
+func TestRoleHasPermission(t *testing.T) {
+	role := &Role{Permissions: RolePermissionManageReports | RolePermissionManageUsers}
+	if !role.HasPermission(RolePermissionManageReports) {
+		t.Fatalf("want permission %d in %d", RolePermissionManageReports, role.Permissions)
+	}
+	if !role.HasPermission(RolePermissionManageReports | RolePermissionManageUsers) {
+		t.Fatalf("want both permissions in %d", role.Permissions)
+	}
+	if role.HasPermission(RolePermissionManageReports | RolePermissionManageRoles) {
+		t.Fatalf("want no permission %d in %d", RolePermissionManageRoles, role.Permissions)
+	}
+}
+
+func TestAccountWarningUnmarshal(t *testing.T) {
+	var warning AccountWarning
+	err := json.Unmarshal([]byte(`{
+		"id": "3",
+		"action": "mark_statuses_as_sensitive",
+		"text": "",
+		"status_ids": ["109", "110"],
+		"target_account": {"id": "1", "username": "foo"},
+		"appeal": {"text": "not sensitive", "state": "pending"},
+		"created_at": "2023-07-21T13:01:17.000Z"
+	}`), &warning)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if warning.Action != AccountWarningActionMarkStatusesAsSensitive || len(warning.StatusIDs) != 2 {
+		t.Fatalf("want %q on two statuses but %q, %v", AccountWarningActionMarkStatusesAsSensitive, warning.Action, warning.StatusIDs)
+	}
+	if warning.Appeal == nil || warning.Appeal.State != AppealStatePending {
+		t.Fatalf("want %q appeal but %v", AppealStatePending, warning.Appeal)
+	}
+}
+
 func TestAdminAccountPerformAction(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/admin/accounts/1234567/action" || r.Method != http.MethodPost {
@@ -175,6 +302,80 @@ func TestGetAdminReports(t *testing.T) {
 	}
 }
 
+func TestGetAdminReportsFilter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/reports" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if r.URL.RawQuery != "account_id=1&resolved=true&target_account_id=2" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `[{"id": "1", "category": "violation", "forwarded": true, "status_ids": ["100"], "rules": [{"id": "3", "text": "No spam"}], "rule_ids": ["3"]}]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	reports, err := client.GetAdminReportsFilter(context.Background(), &AdminReportsFilter{
+		Resolved:        true,
+		AccountID:       "1",
+		TargetAccountID: "2",
+	}, nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("result should be one: %d", len(reports))
+	}
+	if reports[0].Category != ReportCategoryViolation || !reports[0].Forwarded {
+		t.Fatalf("want forwarded %q report but %v", ReportCategoryViolation, reports[0])
+	}
+	if len(reports[0].StatusIDs) != 1 || reports[0].StatusIDs[0] != "100" {
+		t.Fatalf("want status ids %v but %v", []ID{"100"}, reports[0].StatusIDs)
+	}
+	if len(reports[0].Rules) != 1 || reports[0].Rules[0].Text != "No spam" {
+		t.Fatalf("want rule %q but %v", "No spam", reports[0].Rules)
+	}
+}
+
+func TestUpdateAdminReport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/admin/reports/1" || r.Method != http.MethodPut {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("category") != "violation" || len(r.PostForm["rule_ids[]"]) != 2 {
+			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintln(w, `{"id": "1", "category": "violation", "rule_ids": ["3", "4"]}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	report, err := client.UpdateAdminReport(context.Background(), "1", ReportCategoryViolation, []ID{"3", "4"})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(report.RuleIDs) != 2 {
+		t.Fatalf("want two rule ids but %v", report.RuleIDs)
+	}
+	if _, err := client.UpdateAdminReport(context.Background(), "1", ReportCategorySpam, nil); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
+
 func TestGetAdminReport(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/admin/reports/1234567" {
@@ -236,6 +437,154 @@ func TestAdminReportActions(t *testing.T) {
 	}
 }
 
+func TestAdminIPBlocks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/ip_blocks":
+			fmt.Fprintln(w, `[{"id": "1", "ip": "192.0.2.0/24", "severity": "no_access", "expires_at": null}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/ip_blocks/1":
+			fmt.Fprintln(w, `{"id": "1", "ip": "192.0.2.0/24", "severity": "no_access", "expires_at": "2022-11-16T21:40:44.000Z"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/ip_blocks":
+			if r.PostForm.Get("ip") != "198.51.100.0/24" || r.PostForm.Get("severity") != "sign_up_block" || r.PostForm.Get("expires_in") == "" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "2", "ip": "198.51.100.0/24", "severity": "sign_up_block"}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/admin/ip_blocks/2":
+			fmt.Fprintf(w, `{"id": "2", "ip": "198.51.100.0/24", "severity": %q, "comment": %q}`, r.PostForm.Get("severity"), r.PostForm.Get("comment"))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/ip_blocks/2":
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	blocks, err := client.GetAdminIPBlocks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ExpiresAt != nil {
+		t.Fatalf("want one block without expiry but %v", blocks)
+	}
+	block, err := client.GetAdminIPBlock(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.ExpiresAt == nil || block.Severity != IPBlockSeverityNoAccess {
+		t.Fatalf("want %q block with expiry but %v", IPBlockSeverityNoAccess, block)
+	}
+
+	if _, err := client.CreateAdminIPBlock(context.Background(), &AdminIPBlock{Severity: IPBlockSeverityNoAccess}); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	expiresAt := time.Now().Add(24 * time.Hour)
+	block, err = client.CreateAdminIPBlock(context.Background(), &AdminIPBlock{
+		IP:        "198.51.100.0/24",
+		Severity:  IPBlockSeveritySignUpBlock,
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	block, err = client.UpdateAdminIPBlock(context.Background(), block.ID, &AdminIPBlock{
+		Severity: IPBlockSeveritySignUpRequiresApproval,
+		Comment:  "spam wave",
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.Severity != IPBlockSeveritySignUpRequiresApproval || block.Comment != "spam wave" {
+		t.Fatalf("want %q, %q but %q, %q", IPBlockSeveritySignUpRequiresApproval, "spam wave", block.Severity, block.Comment)
+	}
+	if err := client.DeleteAdminIPBlock(context.Background(), block.ID); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
+
+func TestAdminCanonicalEmailBlocks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/canonical_email_blocks":
+			fmt.Fprintln(w, `[{"id": "1", "canonical_email_hash": "b344e55d11b3fc25d0d53194e0475838bf17e9be67ce3e6469956222d9a34f9c"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/canonical_email_blocks/1":
+			fmt.Fprintln(w, `{"id": "1", "canonical_email_hash": "b344e55d11b3fc25d0d53194e0475838bf17e9be67ce3e6469956222d9a34f9c"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/canonical_email_blocks/test":
+			if r.PostForm.Get("email") != "foo@example.com" {
+				fmt.Fprintln(w, `[]`)
+				return
+			}
+			fmt.Fprintln(w, `[{"id": "1", "canonical_email_hash": "b344e55d11b3fc25d0d53194e0475838bf17e9be67ce3e6469956222d9a34f9c"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/canonical_email_blocks":
+			if r.PostForm.Get("email") == "" && r.PostForm.Get("canonical_email_hash") == "" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "2", "canonical_email_hash": "abc"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/canonical_email_blocks/2":
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	blocks, err := client.GetAdminCanonicalEmailBlocks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("result should be one: %d", len(blocks))
+	}
+	block, err := client.GetAdminCanonicalEmailBlock(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if block.CanonicalEmailHash != blocks[0].CanonicalEmailHash {
+		t.Fatalf("want %q but %q", blocks[0].CanonicalEmailHash, block.CanonicalEmailHash)
+	}
+	blocks, err = client.TestAdminCanonicalEmailBlocks(context.Background(), "foo@example.com")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("result should be one: %d", len(blocks))
+	}
+	blocks, err = client.TestAdminCanonicalEmailBlocks(context.Background(), "bar@example.com")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(blocks) != 0 {
+		t.Fatalf("result should be empty: %d", len(blocks))
+	}
+	block, err = client.CreateAdminCanonicalEmailBlock(context.Background(), "bar@example.com")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if _, err := client.CreateAdminCanonicalEmailBlockHash(context.Background(), "abc"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DeleteAdminCanonicalEmailBlock(context.Background(), block.ID); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
+
 func TestRevokeToken(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/oauth/revoke" || r.Method != http.MethodPost {
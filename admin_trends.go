@@ -0,0 +1,190 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AdminTrendsLink holds a trending link with its review state.
+type AdminTrendsLink struct {
+	Card
+	ID             ID        `json:"id"`
+	History        []History `json:"history"`
+	RequiresReview bool      `json:"requires_review"`
+}
+
+// AdminTrendsStatus holds a trending status with its review state.
+type AdminTrendsStatus struct {
+	Status
+	RequiresReview bool `json:"requires_review"`
+}
+
+// UnmarshalJSON decodes the status and its review state; without it the
+// UnmarshalJSON of the embedded Status would drop requires_review.
+func (s *AdminTrendsStatus) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &s.Status); err != nil {
+		return err
+	}
+	var v struct {
+		RequiresReview bool `json:"requires_review"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	s.RequiresReview = v.RequiresReview
+	return nil
+}
+
+// AdminTag holds the admin-level view of a hashtag.
+type AdminTag struct {
+	Tag
+	DisplayName    string `json:"display_name"`
+	Trendable      bool   `json:"trendable"`
+	Usable         bool   `json:"usable"`
+	Listable       bool   `json:"listable"`
+	RequiresReview bool   `json:"requires_review"`
+}
+
+// AdminTagUpdate specifies the changes to a hashtag.
+// Nil fields are not updated.
+type AdminTagUpdate struct {
+	DisplayName *string
+	Trendable   *bool
+	Usable      *bool
+	Listable    *bool
+}
+
+// GetAdminTrendingLinks returns the trending links, including the ones pending review.
+func (c *Client) GetAdminTrendingLinks(ctx context.Context, pg *Pagination) ([]*AdminTrendsLink, error) {
+	var links []*AdminTrendsLink
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/trends/links", nil, &links, pg)
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// AdminTrendingLinkApprove allows the link of id to trend.
+func (c *Client) AdminTrendingLinkApprove(ctx context.Context, id ID) (*AdminTrendsLink, error) {
+	return c.adminTrendingLinkPost(ctx, id, "approve")
+}
+
+// AdminTrendingLinkReject prevents the link of id from trending.
+func (c *Client) AdminTrendingLinkReject(ctx context.Context, id ID) (*AdminTrendsLink, error) {
+	return c.adminTrendingLinkPost(ctx, id, "reject")
+}
+
+func (c *Client) adminTrendingLinkPost(ctx context.Context, id ID, action string) (*AdminTrendsLink, error) {
+	var link AdminTrendsLink
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/admin/trends/links/%s/%s", url.PathEscape(string(id)), action), nil, &link, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// GetAdminTrendingStatuses returns the trending statuses, including the ones pending review.
+func (c *Client) GetAdminTrendingStatuses(ctx context.Context, pg *Pagination) ([]*AdminTrendsStatus, error) {
+	var statuses []*AdminTrendsStatus
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/trends/statuses", nil, &statuses, pg)
+	if err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// AdminTrendingStatusApprove allows the status of id to trend.
+func (c *Client) AdminTrendingStatusApprove(ctx context.Context, id ID) (*AdminTrendsStatus, error) {
+	return c.adminTrendingStatusPost(ctx, id, "approve")
+}
+
+// AdminTrendingStatusReject prevents the status of id from trending.
+func (c *Client) AdminTrendingStatusReject(ctx context.Context, id ID) (*AdminTrendsStatus, error) {
+	return c.adminTrendingStatusPost(ctx, id, "reject")
+}
+
+func (c *Client) adminTrendingStatusPost(ctx context.Context, id ID, action string) (*AdminTrendsStatus, error) {
+	var status AdminTrendsStatus
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/admin/trends/statuses/%s/%s", url.PathEscape(string(id)), action), nil, &status, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// GetAdminTrendingTags returns the trending hashtags, including the ones pending review.
+func (c *Client) GetAdminTrendingTags(ctx context.Context, pg *Pagination) ([]*AdminTag, error) {
+	var tags []*AdminTag
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/trends/tags", nil, &tags, pg)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// AdminTrendingTagApprove allows the hashtag of id to trend.
+func (c *Client) AdminTrendingTagApprove(ctx context.Context, id ID) (*AdminTag, error) {
+	return c.adminTrendingTagPost(ctx, id, "approve")
+}
+
+// AdminTrendingTagReject prevents the hashtag of id from trending.
+func (c *Client) AdminTrendingTagReject(ctx context.Context, id ID) (*AdminTag, error) {
+	return c.adminTrendingTagPost(ctx, id, "reject")
+}
+
+func (c *Client) adminTrendingTagPost(ctx context.Context, id ID, action string) (*AdminTag, error) {
+	var tag AdminTag
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/admin/trends/tags/%s/%s", url.PathEscape(string(id)), action), nil, &tag, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetAdminTags returns the hashtags known to the instance.
+func (c *Client) GetAdminTags(ctx context.Context, pg *Pagination) ([]*AdminTag, error) {
+	var tags []*AdminTag
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/admin/tags", nil, &tags, pg)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetAdminTag returns the hashtag of id.
+func (c *Client) GetAdminTag(ctx context.Context, id ID) (*AdminTag, error) {
+	var tag AdminTag
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/admin/tags/%s", url.PathEscape(string(id))), nil, &tag, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// UpdateAdminTag updates the hashtag of id.
+func (c *Client) UpdateAdminTag(ctx context.Context, id ID, update *AdminTagUpdate) (*AdminTag, error) {
+	params := url.Values{}
+	if update.DisplayName != nil {
+		params.Set("display_name", *update.DisplayName)
+	}
+	if update.Trendable != nil {
+		params.Set("trendable", strconv.FormatBool(*update.Trendable))
+	}
+	if update.Usable != nil {
+		params.Set("usable", strconv.FormatBool(*update.Usable))
+	}
+	if update.Listable != nil {
+		params.Set("listable", strconv.FormatBool(*update.Listable))
+	}
+
+	var tag AdminTag
+	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/admin/tags/%s", url.PathEscape(string(id))), params, &tag, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
@@ -0,0 +1,187 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdminTrendingLinks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/trends/links":
+			fmt.Fprintln(w, `[{"id": "1", "url": "https://example.com/", "title": "Example", "requires_review": true, "history": [{"day": "1668556800", "uses": "3", "accounts": "2"}]}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/links/1/approve":
+			fmt.Fprintln(w, `{"id": "1", "url": "https://example.com/", "requires_review": false}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/links/1/reject":
+			fmt.Fprintln(w, `{"id": "1", "url": "https://example.com/", "requires_review": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	links, err := client.GetAdminTrendingLinks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(links) != 1 {
+		t.Fatalf("result should be one: %d", len(links))
+	}
+	if links[0].Title != "Example" || !links[0].RequiresReview || len(links[0].History) != 1 {
+		t.Fatalf("want link %q pending review but %v", "Example", links[0])
+	}
+	for _, f := range []func(context.Context, ID) (*AdminTrendsLink, error){
+		client.AdminTrendingLinkApprove,
+		client.AdminTrendingLinkReject,
+	} {
+		link, err := f(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		if link.URL != "https://example.com/" {
+			t.Fatalf("want %q but %q", "https://example.com/", link.URL)
+		}
+	}
+}
+
+func TestAdminTrendingStatuses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/trends/statuses":
+			fmt.Fprintln(w, `[{"id": "1", "content": "zzz", "requires_review": true}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/statuses/1/approve",
+			r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/statuses/1/reject":
+			fmt.Fprintln(w, `{"id": "1", "content": "zzz", "requires_review": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	statuses, err := client.GetAdminTrendingStatuses(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Content != "zzz" || !statuses[0].RequiresReview {
+		t.Fatalf("want status %q pending review but %v", "zzz", statuses)
+	}
+	for _, f := range []func(context.Context, ID) (*AdminTrendsStatus, error){
+		client.AdminTrendingStatusApprove,
+		client.AdminTrendingStatusReject,
+	} {
+		status, err := f(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		if status.ID != "1" {
+			t.Fatalf("want %q but %q", "1", status.ID)
+		}
+	}
+	if _, err := client.AdminTrendingStatusApprove(context.Background(), "2"); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
+
+func TestAdminTrendingTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/trends/tags":
+			fmt.Fprintln(w, `[{"id": "1", "name": "caturday", "url": "https://example.com/tags/caturday", "trendable": false, "requires_review": true}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/tags/1/approve":
+			fmt.Fprintln(w, `{"id": "1", "name": "caturday", "trendable": true}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/trends/tags/1/reject":
+			fmt.Fprintln(w, `{"id": "1", "name": "caturday", "trendable": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	tags, err := client.GetAdminTrendingTags(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Name != "caturday" || !tags[0].RequiresReview {
+		t.Fatalf("want tag %q pending review but %v", "caturday", tags)
+	}
+	tag, err := client.AdminTrendingTagApprove(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !tag.Trendable {
+		t.Fatalf("want %v but %v", true, tag.Trendable)
+	}
+	tag, err = client.AdminTrendingTagReject(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if tag.Trendable {
+		t.Fatalf("want %v but %v", false, tag.Trendable)
+	}
+}
+
+func TestAdminTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/tags":
+			fmt.Fprintln(w, `[{"id": "1", "name": "caturday", "usable": true}, {"id": "2", "name": "dogs", "usable": false}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/tags/1":
+			fmt.Fprintln(w, `{"id": "1", "name": "caturday", "display_name": "Caturday", "listable": true}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/admin/tags/1":
+			if _, ok := r.PostForm["display_name"]; ok || r.PostForm.Get("usable") != "false" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "1", "name": "caturday", "usable": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	tags, err := client.GetAdminTags(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(tags) != 2 || !tags[0].Usable || tags[1].Usable {
+		t.Fatalf("want two tags but %v", tags)
+	}
+	tag, err := client.GetAdminTag(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if tag.DisplayName != "Caturday" || !tag.Listable {
+		t.Fatalf("want listable %q but %v", "Caturday", tag)
+	}
+	usable := false
+	tag, err = client.UpdateAdminTag(context.Background(), "1", &AdminTagUpdate{Usable: &usable})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if tag.Usable {
+		t.Fatalf("want %v but %v", false, tag.Usable)
+	}
+}
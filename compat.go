@@ -57,6 +57,14 @@ func (i ID) Compare(j ID) int {
 type Sbool bool
 
 func (s *Sbool) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9')) {
+		var n float64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+		*s = Sbool(n != 0)
+		return nil
+	}
 	if len(data) > 0 && data[0] == '"' && data[len(data)-1] == '"' {
 		var str string
 		if err := json.Unmarshal(data, &str); err != nil {
@@ -76,3 +84,12 @@ func (s *Sbool) UnmarshalJSON(data []byte) error {
 	*s = Sbool(b)
 	return nil
 }
+
+// ptr returns s as a *bool, keeping nil for absent or null values.
+func (s *Sbool) ptr() *bool {
+	if s == nil {
+		return nil
+	}
+	b := bool(*s)
+	return &b
+}
@@ -2,6 +2,7 @@ package mastodon
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 )
 
@@ -30,6 +31,41 @@ type InstanceConfig struct {
 	Polls            *InstanceConfigMap     `json:"polls"`
 }
 
+// MediaAttachmentsConfig holds the limits of an instance for media attachments.
+type MediaAttachmentsConfig struct {
+	SupportedMIMETypes  []string `json:"supported_mime_types"`
+	DescriptionLimit    int64    `json:"description_limit"`
+	ImageSizeLimit      int64    `json:"image_size_limit"`
+	ImageMatrixLimit    int64    `json:"image_matrix_limit"`
+	VideoSizeLimit      int64    `json:"video_size_limit"`
+	VideoFrameRateLimit int64    `json:"video_frame_rate_limit"`
+	VideoMatrixLimit    int64    `json:"video_matrix_limit"`
+}
+
+// MediaAttachmentsConfig returns the typed media attachment limits of the instance.
+func (c *InstanceConfig) MediaAttachmentsConfig() (*MediaAttachmentsConfig, error) {
+	var cfg MediaAttachmentsConfig
+	if err := decodeInstanceConfig(c.MediaAttachments, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func decodeInstanceConfig(m map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Rule holds information for a rule of an instance.
+type Rule struct {
+	ID   ID     `json:"id"`
+	Text string `json:"text"`
+	Hint string `json:"hint"`
+}
+
 // InstanceStats holds information for mastodon instance stats.
 type InstanceStats struct {
 	UserCount   int64 `json:"user_count"`
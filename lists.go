@@ -3,14 +3,47 @@ package mastodon
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
+	"strconv"
+)
+
+// Convenience constants for List.RepliesPolicy
+const (
+	ListRepliesPolicyFollowed = "followed"
+	ListRepliesPolicyList     = "list"
+	ListRepliesPolicyNone     = "none"
 )
 
 // List is metadata for a list of users.
 type List struct {
-	ID    ID     `json:"id"`
-	Title string `json:"title"`
+	ID            ID     `json:"id"`
+	Title         string `json:"title"`
+	RepliesPolicy string `json:"replies_policy"`
+	Exclusive     bool   `json:"exclusive"`
+}
+
+// ListParams specifies a list created by CreateListWithParams or updated by UpdateList.
+type ListParams struct {
+	Title string
+	// RepliesPolicy is one of followed, list and none. Empty keeps the server default.
+	RepliesPolicy string
+	// Exclusive removes the statuses of the list members from the home timeline.
+	// Nil keeps the server default.
+	Exclusive *bool
+}
+
+func (p *ListParams) toValues() url.Values {
+	params := url.Values{}
+	params.Set("title", p.Title)
+	if p.RepliesPolicy != "" {
+		params.Set("replies_policy", p.RepliesPolicy)
+	}
+	if p.Exclusive != nil {
+		params.Set("exclusive", strconv.FormatBool(*p.Exclusive))
+	}
+	return params
 }
 
 // GetLists returns all the lists on the current account.
@@ -33,7 +66,46 @@ func (c *Client) GetAccountLists(ctx context.Context, id ID) ([]*List, error) {
 	return lists, nil
 }
 
+// ListAccounts iterates over the accounts in a given list, page by page.
+func (c *Client) ListAccounts(ctx context.Context, id ID, pg *Pagination) iter.Seq2[*Account, error] {
+	return func(yield func(*Account, error) bool) {
+		var zero Pagination
+		if pg == nil {
+			pg = &Pagination{}
+		}
+		for {
+			vs, err := c.GetListAccountsPage(ctx, id, pg)
+			if err != nil {
+				_ = yield(nil, err)
+				return
+			}
+
+			for _, v := range vs {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if *pg == zero {
+				return
+			}
+		}
+	}
+}
+
+// GetListAccountsPage returns a page of the accounts in a given list.
+func (c *Client) GetListAccountsPage(ctx context.Context, id ID, pg *Pagination) ([]*Account, error) {
+	var accounts []*Account
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/accounts", url.PathEscape(string(id))), nil, &accounts, pg)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
 // GetListAccounts returns the accounts in a given list.
+//
+// All the accounts are requested at once; use ListAccounts for large lists.
 func (c *Client) GetListAccounts(ctx context.Context, id ID) ([]*Account, error) {
 	var accounts []*Account
 	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/lists/%s/accounts", url.PathEscape(string(id))), url.Values{"limit": {"0"}}, &accounts, nil)
@@ -55,11 +127,13 @@ func (c *Client) GetList(ctx context.Context, id ID) (*List, error) {
 
 // CreateList creates a new list with a given title.
 func (c *Client) CreateList(ctx context.Context, title string) (*List, error) {
-	params := url.Values{}
-	params.Set("title", title)
+	return c.CreateListWithParams(ctx, &ListParams{Title: title})
+}
 
+// CreateListWithParams creates a new list with the title, replies policy and exclusivity of params.
+func (c *Client) CreateListWithParams(ctx context.Context, params *ListParams) (*List, error) {
 	var list List
-	err := c.doAPI(ctx, http.MethodPost, "/api/v1/lists", params, &list, nil)
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/lists", params.toValues(), &list, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,11 +142,13 @@ func (c *Client) CreateList(ctx context.Context, title string) (*List, error) {
 
 // RenameList assigns a new title to a list.
 func (c *Client) RenameList(ctx context.Context, id ID, title string) (*List, error) {
-	params := url.Values{}
-	params.Set("title", title)
+	return c.UpdateList(ctx, id, &ListParams{Title: title})
+}
 
+// UpdateList assigns a new title, replies policy and exclusivity to a list.
+func (c *Client) UpdateList(ctx context.Context, id ID, params *ListParams) (*List, error) {
 	var list List
-	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/lists/%s", url.PathEscape(string(id))), params, &list, nil)
+	err := c.doAPI(ctx, http.MethodPut, fmt.Sprintf("/api/v1/lists/%s", url.PathEscape(string(id))), params.toValues(), &list, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +109,51 @@ func TestGetListAccounts(t *testing.T) {
 	}
 }
 
+func TestListAccounts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/lists/1/accounts" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if r.FormValue("limit") != "2" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		switch r.FormValue("max_id") {
+		case "":
+			w.Header().Set("Link", `<http://example.com?max_id=2&limit=2>; rel="next"`)
+			fmt.Fprintln(w, `[{"id": "4", "username": "foo"}, {"id": "3", "username": "bar"}]`)
+		case "2":
+			fmt.Fprintln(w, `[{"id": "2", "username": "baz"}]`)
+		default:
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	for _, err := range client.ListAccounts(context.Background(), "2", &Pagination{Limit: 2}) {
+		if err == nil {
+			t.Fatalf("should be fail: %v", err)
+		}
+	}
+	var usernames []string
+	for account, err := range client.ListAccounts(context.Background(), "1", &Pagination{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		usernames = append(usernames, account.Username)
+	}
+	if len(usernames) != 3 || usernames[2] != "baz" {
+		t.Fatalf("want %v but %v", []string{"foo", "bar", "baz"}, usernames)
+	}
+}
+
 func TestGetList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/lists/1" {
@@ -200,6 +245,57 @@ func TestRenameList(t *testing.T) {
 	}
 }
 
+func TestCreateListWithParams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/lists" && r.URL.Path != "/api/v1/lists/1" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.PostForm.Get("replies_policy") == "none" && r.PostForm.Get("exclusive") == "true":
+			fmt.Fprintf(w, `{"id": "1", "title": %q, "replies_policy": "none", "exclusive": true}`, r.PostForm.Get("title"))
+		case r.Method == http.MethodPut && r.PostForm.Get("replies_policy") == "list" && r.PostForm.Get("exclusive") == "":
+			fmt.Fprintf(w, `{"id": "1", "title": %q, "replies_policy": "list", "exclusive": true}`, r.PostForm.Get("title"))
+		default:
+			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	exclusive := true
+	list, err := client.CreateListWithParams(context.Background(), &ListParams{
+		Title:         "foo",
+		RepliesPolicy: ListRepliesPolicyNone,
+		Exclusive:     &exclusive,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if list.Title != "foo" || list.RepliesPolicy != ListRepliesPolicyNone || !list.Exclusive {
+		t.Fatalf("want exclusive %q with %q but %v", "foo", ListRepliesPolicyNone, list)
+	}
+	list, err = client.UpdateList(context.Background(), "1", &ListParams{
+		Title:         "bar",
+		RepliesPolicy: ListRepliesPolicyList,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if list.Title != "bar" || list.RepliesPolicy != ListRepliesPolicyList {
+		t.Fatalf("want %q with %q but %v", "bar", ListRepliesPolicyList, list)
+	}
+}
+
 func TestDeleteList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/lists/1" {
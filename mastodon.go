@@ -64,15 +64,18 @@ func (c *Client) doAPI(ctx context.Context, method string, uri string, params in
 			return err
 		}
 	} else if media, ok := params.(*Media); ok {
-		r, contentType, err := media.bodyAndContentType()
+		body, contentType, length, getBody, err := media.body()
 		if err != nil {
 			return err
 		}
 
-		req, err = http.NewRequest(method, u.String(), r)
+		req, err = http.NewRequest(method, u.String(), body)
 		if err != nil {
+			body.Close()
 			return err
 		}
+		req.ContentLength = length
+		req.GetBody = getBody
 
 		ct = contentType
 	} else {
@@ -121,6 +124,9 @@ func (c *Client) doAPI(ctx context.Context, method string, uri string, params in
 				if err != nil {
 					return err
 				}
+			} else if req.Body != nil {
+				// a streamed body that can't be sent again.
+				break
 			}
 
 			backoff = time.Duration(1.5 * float64(backoff))
@@ -368,16 +374,19 @@ type Mention struct {
 
 // Tag hold information for tag.
 type Tag struct {
-	Name    string    `json:"name"`
-	URL     string    `json:"url"`
-	History []History `json:"history"`
+	ID        ID        `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	History   []History `json:"history"`
+	Following bool      `json:"following"`
+	Featuring bool      `json:"featuring"`
 }
 
-// History hold information for history.
+// History hold information for the daily usage of a tag or link.
 type History struct {
-	Day      string `json:"day"`
-	Uses     string `json:"uses"`
-	Accounts string `json:"accounts"`
+	Day      UnixTimeString `json:"day"`
+	Uses     int64          `json:"uses,string"`
+	Accounts int64          `json:"accounts,string"`
 }
 
 // Attachment hold information for attachment.
@@ -364,7 +364,8 @@ func TestPostStatusParams(t *testing.T) {
 			Content: fmt.Sprintf("<p>%s</p>", r.FormValue("status")),
 		}
 		if r.FormValue("in_reply_to_id") != "" {
-			s.InReplyToID = ID(r.FormValue("in_reply_to_id"))
+			inReplyToID := ID(r.FormValue("in_reply_to_id"))
+			s.InReplyToID = &inReplyToID
 		}
 		if r.FormValue("visibility") != "" {
 			s.Visibility = (r.FormValue("visibility"))
@@ -425,8 +426,8 @@ func TestPostStatusParams(t *testing.T) {
 	if s.Content != "<p>foobar</p>" {
 		t.Fatalf("want %q but %q", "<p>foobar</p>", s.Content)
 	}
-	if s.InReplyToID != "2" {
-		t.Fatalf("want %q but %q", "2", s.InReplyToID)
+	if s.InReplyToID == nil || *s.InReplyToID != "2" {
+		t.Fatalf("want %q but %v", "2", s.InReplyToID)
 	}
 	if s.Visibility != "unlisted" {
 		t.Fatalf("want %q but %q", "unlisted", s.Visibility)
@@ -542,7 +543,8 @@ func TestUpdateStatusParams(t *testing.T) {
 			Content: fmt.Sprintf("<p>%s</p>", r.FormValue("status")),
 		}
 		if r.FormValue("in_reply_to_id") != "" {
-			s.InReplyToID = ID(r.FormValue("in_reply_to_id"))
+			inReplyToID := ID(r.FormValue("in_reply_to_id"))
+			s.InReplyToID = &inReplyToID
 		}
 		if r.FormValue("visibility") != "" {
 			s.Visibility = (r.FormValue("visibility"))
@@ -603,8 +605,8 @@ func TestUpdateStatusParams(t *testing.T) {
 	if s.Content != "<p>foobar</p>" {
 		t.Fatalf("want %q but %q", "<p>foobar</p>", s.Content)
 	}
-	if s.InReplyToID != "2" {
-		t.Fatalf("want %q but %q", "2", s.InReplyToID)
+	if s.InReplyToID == nil || *s.InReplyToID != "2" {
+		t.Fatalf("want %q but %v", "2", s.InReplyToID)
 	}
 	if s.Visibility != "unlisted" {
 		t.Fatalf("want %q but %q", "unlisted", s.Visibility)
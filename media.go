@@ -0,0 +1,435 @@
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for Media.Info
+	_ "image/jpeg" // register JPEG for Media.Info
+	_ "image/png"  // register PNG for Media.Info
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Media is struct to hold media.
+type Media struct {
+	File        io.Reader
+	Thumbnail   io.Reader
+	Description string
+	Focus       string
+
+	// Progress is called while the media is uploaded with the number of
+	// bytes sent so far. total is -1 if the size of the upload is unknown.
+	Progress func(sent, total int64)
+}
+
+// mediaPart is a file part of the multipart body of a Media.
+type mediaPart struct {
+	field       string
+	name        string
+	contentType string
+	r           io.Reader
+	size        int64
+
+	// head holds the bytes consumed from r to detect the content type.
+	head []byte
+	// offset is the position r was at before it was read, or -1 if r can't be rewound.
+	offset int64
+}
+
+func newMediaPart(field string, r io.Reader) (*mediaPart, error) {
+	p := &mediaPart{
+		field:  field,
+		name:   "upload",
+		r:      r,
+		size:   readerSize(r),
+		offset: -1,
+	}
+	switch v := r.(type) {
+	case *os.File:
+		p.name = v.Name()
+		p.contentType = mime.TypeByExtension(filepath.Ext(p.name))
+	case *sniffedReader:
+		p.name = v.name
+		p.contentType = v.contentType
+		p.size = v.size
+	}
+	if s, ok := r.(io.Seeker); ok {
+		if offset, err := s.Seek(0, io.SeekCurrent); err == nil {
+			p.offset = offset
+		}
+	}
+
+	if p.contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(r, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		p.head = head[:n]
+		p.contentType = http.DetectContentType(p.head)
+	}
+	return p, nil
+}
+
+// reader returns the content of the part from the beginning.
+func (p *mediaPart) reader() (io.Reader, error) {
+	if p.offset >= 0 {
+		if _, err := p.r.(io.Seeker).Seek(p.offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return p.r, nil
+	}
+	return io.MultiReader(bytes.NewReader(p.head), p.r), nil
+}
+
+func (p *mediaPart) header() textproto.MIMEHeader {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", multipart.FileContentDisposition(p.field, p.name))
+	h.Set("Content-Type", p.contentType)
+	return h
+}
+
+// sniffedReader is a reader whose head was consumed to detect its content type.
+type sniffedReader struct {
+	io.Reader
+	name        string
+	contentType string
+	size        int64
+}
+
+// readerSize returns the number of bytes left in r, or -1 if it is unknown.
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case io.Seeker:
+		cur, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		end, err := v.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
+		}
+		if _, err := v.Seek(cur, io.SeekStart); err != nil {
+			return -1
+		}
+		return end - cur
+	}
+	return -1
+}
+
+// mediaBody streams the multipart body of a Media.
+type mediaBody struct {
+	// mu serializes the writers of the body since they share the part readers.
+	mu       sync.Mutex
+	boundary string
+	parts    []*mediaPart
+	fields   [][2]string
+}
+
+func (b *mediaBody) write(mw *multipart.Writer, content bool) error {
+	if err := mw.SetBoundary(b.boundary); err != nil {
+		return err
+	}
+	for _, p := range b.parts {
+		w, err := mw.CreatePart(p.header())
+		if err != nil {
+			return err
+		}
+		if !content {
+			continue
+		}
+		r, err := p.reader()
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, r); err != nil {
+			return err
+		}
+	}
+	for _, f := range b.fields {
+		if err := mw.WriteField(f[0], f[1]); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// length returns the size of the body, or -1 if the size of a part is unknown.
+func (b *mediaBody) length() (int64, error) {
+	var cw countingWriter
+	if err := b.write(multipart.NewWriter(&cw), false); err != nil {
+		return 0, err
+	}
+	n := int64(cw)
+	for _, p := range b.parts {
+		if p.size < 0 {
+			return -1, nil
+		}
+		n += p.size
+	}
+	return n, nil
+}
+
+func (b *mediaBody) open() io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		pw.CloseWithError(b.write(multipart.NewWriter(pw), true))
+	}()
+	return pr
+}
+
+type countingWriter int64
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	*w += countingWriter(len(p))
+	return len(p), nil
+}
+
+type progressReader struct {
+	io.ReadCloser
+	sent  int64
+	total int64
+	fn    func(sent, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.fn(r.sent, r.total)
+	}
+	return n, err
+}
+
+// body returns the multipart body of the media, its content type and its
+// length. The body is streamed from File and Thumbnail while it is read, so
+// length is -1 if the size of either of them is unknown. getBody is non-nil
+// if File and Thumbnail can be rewound to send the body again.
+func (m *Media) body() (body io.ReadCloser, contentType string, length int64, getBody func() (io.ReadCloser, error), err error) {
+	mw := multipart.NewWriter(nil)
+	b := &mediaBody{boundary: mw.Boundary()}
+	if m.File != nil {
+		p, err := newMediaPart("file", m.File)
+		if err != nil {
+			return nil, "", 0, nil, err
+		}
+		b.parts = append(b.parts, p)
+	}
+	if m.Thumbnail != nil {
+		p, err := newMediaPart("thumbnail", m.Thumbnail)
+		if err != nil {
+			return nil, "", 0, nil, err
+		}
+		b.parts = append(b.parts, p)
+	}
+	if m.Description != "" {
+		b.fields = append(b.fields, [2]string{"description", m.Description})
+	}
+	if m.Focus != "" {
+		b.fields = append(b.fields, [2]string{"focus", m.Focus})
+	}
+
+	length, err = b.length()
+	if err != nil {
+		return nil, "", 0, nil, err
+	}
+
+	open := func() (io.ReadCloser, error) {
+		r := b.open()
+		if m.Progress != nil {
+			r = &progressReader{ReadCloser: r, total: length, fn: m.Progress}
+		}
+		return r, nil
+	}
+	rewindable := true
+	for _, p := range b.parts {
+		if p.offset < 0 {
+			rewindable = false
+		}
+	}
+	if rewindable {
+		getBody = open
+	}
+
+	body, _ = open()
+	return body, mw.FormDataContentType(), length, getBody, nil
+}
+
+// Convenience constants for MediaValidationError.Field
+const (
+	MediaFieldType        = "type"
+	MediaFieldSize        = "size"
+	MediaFieldMatrix      = "matrix"
+	MediaFieldFrameRate   = "frame_rate"
+	MediaFieldDescription = "description"
+)
+
+// MediaInfo describes a media file to be checked against the limits of an instance.
+// Zero or negative values are unknown and are not checked. An empty or
+// application/octet-stream ContentType is unknown too, so it is not checked
+// against the supported types and the looser of the image and video limits apply.
+type MediaInfo struct {
+	ContentType string
+	Size        int64
+	Width       int64
+	Height      int64
+	FrameRate   float64
+	Description string
+}
+
+// MediaValidationError is returned when a media exceeds the limits of an instance.
+type MediaValidationError struct {
+	// Field is one of type, size, matrix, frame_rate and description.
+	Field       string
+	ContentType string
+	Value       float64
+	Limit       float64
+}
+
+func (e *MediaValidationError) Error() string {
+	if e.Field == MediaFieldType {
+		return fmt.Sprintf("media type %s is not supported", e.ContentType)
+	}
+	return fmt.Sprintf("media %s %s of %s exceeds the limit of %s", e.Field,
+		strconv.FormatFloat(e.Value, 'f', -1, 64), e.ContentType, strconv.FormatFloat(e.Limit, 'f', -1, 64))
+}
+
+// Check returns a *MediaValidationError if info exceeds the limits.
+func (c *MediaAttachmentsConfig) Check(info *MediaInfo) error {
+	ct := info.ContentType
+	if mt, _, err := mime.ParseMediaType(ct); err == nil {
+		ct = mt
+	}
+	unknown := ct == "" || ct == "application/octet-stream"
+	if !unknown && len(c.SupportedMIMETypes) > 0 && !slices.Contains(c.SupportedMIMETypes, ct) {
+		return &MediaValidationError{Field: MediaFieldType, ContentType: ct}
+	}
+
+	sizeLimit, matrixLimit := c.ImageSizeLimit, c.ImageMatrixLimit
+	video := strings.HasPrefix(ct, "video/") || strings.HasPrefix(ct, "audio/")
+	if video {
+		sizeLimit, matrixLimit = c.VideoSizeLimit, c.VideoMatrixLimit
+	}
+	if unknown {
+		// the media may be an image or a video.
+		sizeLimit = looserLimit(c.ImageSizeLimit, c.VideoSizeLimit)
+		matrixLimit = looserLimit(c.ImageMatrixLimit, c.VideoMatrixLimit)
+		video = true
+	}
+	check := func(field string, value float64, limit int64) error {
+		if value > 0 && limit > 0 && value > float64(limit) {
+			return &MediaValidationError{Field: field, ContentType: ct, Value: value, Limit: float64(limit)}
+		}
+		return nil
+	}
+	if err := check(MediaFieldSize, float64(info.Size), sizeLimit); err != nil {
+		return err
+	}
+	if err := check(MediaFieldMatrix, float64(info.Width*info.Height), matrixLimit); err != nil {
+		return err
+	}
+	if video {
+		if err := check(MediaFieldFrameRate, info.FrameRate, c.VideoFrameRateLimit); err != nil {
+			return err
+		}
+	}
+	return check(MediaFieldDescription, float64(utf8.RuneCountInString(info.Description)), c.DescriptionLimit)
+}
+
+// looserLimit returns the larger of the limits a and b, where zero means no limit.
+func looserLimit(a, b int64) int64 {
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	return max(a, b)
+}
+
+// Info detects the content type and size of the media file and, for images,
+// its dimensions. The dimensions and frame rate of videos are not detected.
+func (m *Media) Info() (*MediaInfo, error) {
+	if m.File == nil {
+		return nil, errors.New("media file can't be nil")
+	}
+	p, err := newMediaPart("file", m.File)
+	if err != nil {
+		return nil, err
+	}
+	info := &MediaInfo{
+		ContentType: p.contentType,
+		Size:        p.size,
+		Description: m.Description,
+	}
+
+	if strings.HasPrefix(p.contentType, "image/") {
+		var r io.Reader = bytes.NewReader(p.head)
+		if p.offset >= 0 {
+			if r, err = p.reader(); err != nil {
+				return nil, err
+			}
+		}
+		if cfg, _, err := image.DecodeConfig(r); err == nil {
+			info.Width, info.Height = int64(cfg.Width), int64(cfg.Height)
+		}
+	}
+
+	if p.offset >= 0 {
+		// rewind the file for the upload.
+		if _, err := p.reader(); err != nil {
+			return nil, err
+		}
+	} else {
+		// keep the consumed head for the upload.
+		m.File = &sniffedReader{
+			Reader:      io.MultiReader(bytes.NewReader(p.head), p.r),
+			name:        p.name,
+			contentType: p.contentType,
+			size:        p.size,
+		}
+	}
+	return info, nil
+}
+
+// ValidateMedia checks the media against the media attachment limits of the
+// instance, so that a media which would be rejected isn't uploaded.
+// The dimensions and frame rate of videos are not detected by Media.Info, so
+// they are not checked; measure them and use ValidateMediaInfo instead.
+func (c *Client) ValidateMedia(ctx context.Context, media *Media) error {
+	info, err := media.Info()
+	if err != nil {
+		return err
+	}
+	return c.ValidateMediaInfo(ctx, info)
+}
+
+// ValidateMediaInfo checks info against the media attachment limits of the instance.
+func (c *Client) ValidateMediaInfo(ctx context.Context, info *MediaInfo) error {
+	instance, err := c.GetInstance(ctx)
+	if err != nil {
+		return err
+	}
+	if instance.Configuration == nil {
+		return nil
+	}
+	cfg, err := instance.Configuration.MediaAttachmentsConfig()
+	if err != nil {
+		return err
+	}
+	return cfg.Check(info)
+}
@@ -0,0 +1,257 @@
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMediaBody(t *testing.T) {
+	png, err := os.ReadFile("testdata/logo.png")
+	if err != nil {
+		t.Fatalf("could not open file: %v", err)
+	}
+
+	var lastSent, lastTotal int64
+	media := &Media{
+		File:        bytes.NewReader(png),
+		Thumbnail:   bytes.NewBufferString("GIF89a thumbnail"),
+		Description: "logo",
+		Focus:       "0.1,0.2",
+		Progress: func(sent, total int64) {
+			lastSent, lastTotal = sent, total
+		},
+	}
+	body, contentType, length, getBody, err := media.body()
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	defer body.Close()
+	if getBody != nil {
+		t.Fatalf("body with a bytes.Buffer thumbnail should not be rewindable")
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if int64(len(b)) != length {
+		t.Fatalf("want length %d but %d", len(b), length)
+	}
+	if lastSent != length || lastTotal != length {
+		t.Fatalf("want progress %d/%d but %d/%d", length, length, lastSent, lastTotal)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "/", bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if err := req.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	file := req.MultipartForm.File["file"][0]
+	if ct := file.Header.Get("Content-Type"); ct != "image/png" {
+		t.Fatalf("want %q but %q", "image/png", ct)
+	}
+	if file.Size != int64(len(png)) {
+		t.Fatalf("want size %d but %d", len(png), file.Size)
+	}
+	thumb := req.MultipartForm.File["thumbnail"][0]
+	if ct := thumb.Header.Get("Content-Type"); ct != "image/gif" {
+		t.Fatalf("want %q but %q", "image/gif", ct)
+	}
+	if req.FormValue("description") != "logo" || req.FormValue("focus") != "0.1,0.2" {
+		t.Fatalf("want fields %q, %q but %q, %q", "logo", "0.1,0.2", req.FormValue("description"), req.FormValue("focus"))
+	}
+}
+
+func TestMediaBodyUnknownLength(t *testing.T) {
+	media := &Media{File: io.MultiReader(strings.NewReader("foo"), strings.NewReader("bar"))}
+	body, _, length, getBody, err := media.body()
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	defer body.Close()
+	if length != -1 {
+		t.Fatalf("want length %d but %d", -1, length)
+	}
+	if getBody != nil {
+		t.Fatalf("body of a plain reader should not be rewindable")
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !bytes.Contains(b, []byte("\r\n\r\nfoobar\r\n")) {
+		t.Fatalf("body should contain the whole file: %q", b)
+	}
+}
+
+func TestUploadMediaStreamed(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			http.Error(w, "throttled", http.StatusTooManyRequests)
+			return
+		}
+		if r.ContentLength <= 0 {
+			http.Error(w, "unknown content length", http.StatusBadRequest)
+			return
+		}
+		f, h, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+		fmt.Fprintf(w, `{"id": "123", "type": %q}`, h.Header.Get("Content-Type"))
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	attachment, err := client.UploadMedia(context.Background(), "testdata/logo.png")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("want %d attempts but %d", 2, attempts)
+	}
+	if attachment.Type != "image/png" {
+		t.Fatalf("want %q but %q", "image/png", attachment.Type)
+	}
+}
+
+func TestValidateMedia(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/instance" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"configuration": {"media_attachments": {"supported_mime_types": ["image/png", "video/mp4"], "description_limit": 10, "image_size_limit": 1048576, "image_matrix_limit": 1000, "video_size_limit": 10, "video_frame_rate_limit": 60, "video_matrix_limit": 2304000}}}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	file, err := os.Open("testdata/logo.png")
+	if err != nil {
+		t.Fatalf("could not open file: %v", err)
+	}
+	defer file.Close()
+
+	err = client.ValidateMedia(context.Background(), &Media{File: file})
+	var verr *MediaValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("want *MediaValidationError but %v", err)
+	}
+	if verr.Field != MediaFieldMatrix || verr.Limit != 1000 {
+		t.Fatalf("want %s error with limit %d but %v", MediaFieldMatrix, 1000, verr)
+	}
+
+	err = client.ValidateMedia(context.Background(), &Media{File: strings.NewReader("GIF89a")})
+	if !errors.As(err, &verr) || verr.Field != MediaFieldType || verr.ContentType != "image/gif" {
+		t.Fatalf("want %s error for %s but %v", MediaFieldType, "image/gif", err)
+	}
+
+	info := &MediaInfo{ContentType: "video/mp4", Width: 3840, Height: 2160, FrameRate: 30}
+	err = client.ValidateMediaInfo(context.Background(), info)
+	if !errors.As(err, &verr) || verr.Field != MediaFieldMatrix || verr.Limit != 2304000 {
+		t.Fatalf("want %s error with limit %d but %v", MediaFieldMatrix, 2304000, err)
+	}
+	info.Width, info.Height, info.FrameRate = 1920, 1080, 120
+	err = client.ValidateMediaInfo(context.Background(), info)
+	if !errors.As(err, &verr) || verr.Field != MediaFieldFrameRate {
+		t.Fatalf("want %s error but %v", MediaFieldFrameRate, err)
+	}
+	info.FrameRate = 60
+	if err := client.ValidateMediaInfo(context.Background(), info); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
+
+func TestMediaAttachmentsConfigCheck(t *testing.T) {
+	cfg := &MediaAttachmentsConfig{
+		SupportedMIMETypes:  []string{"image/png", "video/mp4"},
+		DescriptionLimit:    5,
+		ImageSizeLimit:      100,
+		ImageMatrixLimit:    100,
+		VideoSizeLimit:      1000,
+		VideoFrameRateLimit: 60,
+		VideoMatrixLimit:    1000,
+	}
+	for _, test := range []struct {
+		info  MediaInfo
+		field string
+	}{
+		{MediaInfo{ContentType: "image/png", Size: 100, Width: 10, Height: 10}, ""},
+		{MediaInfo{ContentType: "image/webp"}, MediaFieldType},
+		{MediaInfo{ContentType: "image/png", Size: 101}, MediaFieldSize},
+		{MediaInfo{ContentType: "video/mp4", Size: 101}, ""},
+		{MediaInfo{ContentType: "video/mp4", Width: 100, Height: 11}, MediaFieldMatrix},
+		{MediaInfo{ContentType: "video/mp4", FrameRate: 59.94}, ""},
+		{MediaInfo{ContentType: "video/mp4", FrameRate: 120}, MediaFieldFrameRate},
+		{MediaInfo{ContentType: "image/png", Description: "ログ画像です"}, MediaFieldDescription},
+		{MediaInfo{ContentType: "application/octet-stream", Size: 1000, Width: 30, Height: 30, FrameRate: 30}, ""},
+		{MediaInfo{Size: 1001}, MediaFieldSize},
+		{MediaInfo{ContentType: "application/octet-stream", FrameRate: 120}, MediaFieldFrameRate},
+	} {
+		err := cfg.Check(&test.info)
+		if test.field == "" {
+			if err != nil {
+				t.Fatalf("%+v should be valid: %v", test.info, err)
+			}
+			continue
+		}
+		var verr *MediaValidationError
+		if !errors.As(err, &verr) || verr.Field != test.field {
+			t.Fatalf("%+v: want %s error but %v", test.info, test.field, err)
+		}
+	}
+}
+
+func TestMediaInfoKeepsContent(t *testing.T) {
+	png, err := os.ReadFile("testdata/logo.png")
+	if err != nil {
+		t.Fatalf("could not open file: %v", err)
+	}
+	media := &Media{File: io.MultiReader(bytes.NewReader(png))}
+	info, err := media.Info()
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if info.ContentType != "image/png" {
+		t.Fatalf("want %q but %q", "image/png", info.ContentType)
+	}
+	if info.Width == 0 || info.Height == 0 {
+		t.Fatalf("image dimensions should be detected: %dx%d", info.Width, info.Height)
+	}
+
+	body, _, _, _, err := media.body()
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !bytes.Contains(b, png) {
+		t.Fatalf("body should contain the whole file")
+	}
+	if !bytes.Contains(b, []byte("Content-Type: image/png")) {
+		t.Fatalf("body should keep the detected content type")
+	}
+}
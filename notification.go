@@ -12,27 +12,125 @@ import (
 	"time"
 )
 
+// Notification types of Notification.Type and NotificationGroup.Type
+const (
+	NotificationTypeMention              = "mention"
+	NotificationTypeStatus               = "status"
+	NotificationTypeReblog               = "reblog"
+	NotificationTypeFollow               = "follow"
+	NotificationTypeFollowRequest        = "follow_request"
+	NotificationTypeFavourite            = "favourite"
+	NotificationTypePoll                 = "poll"
+	NotificationTypeUpdate               = "update"
+	NotificationTypeQuote                = "quote"
+	NotificationTypeQuotedUpdate         = "quoted_update"
+	NotificationTypeAdminSignUp          = "admin.sign_up"
+	NotificationTypeAdminReport          = "admin.report"
+	NotificationTypeSeveredRelationships = "severed_relationships"
+	NotificationTypeModerationWarning    = "moderation_warning"
+)
+
 // Notification holds information for a mastodon notification.
 type Notification struct {
 	ID        ID        `json:"id"`
 	Type      string    `json:"type"`
+	GroupKey  string    `json:"group_key"`
 	CreatedAt time.Time `json:"created_at"`
 	Account   Account   `json:"account"`
 	Status    *Status   `json:"status"`
+	// Report is set for the admin.report type.
+	Report *Report `json:"report"`
+	// Event is set for the severed_relationships type.
+	Event *RelationshipSeveranceEvent `json:"event"`
+	// ModerationWarning is set for the moderation_warning type.
+	ModerationWarning *AccountWarning `json:"moderation_warning"`
+}
+
+// Convenience constants for RelationshipSeveranceEvent.Type
+const (
+	RelationshipSeveranceDomainBlock       = "domain_block"
+	RelationshipSeveranceUserDomainBlock   = "user_domain_block"
+	RelationshipSeveranceAccountSuspension = "account_suspension"
+)
+
+// RelationshipSeveranceEvent holds the follow relationships lost to a moderation action.
+type RelationshipSeveranceEvent struct {
+	ID                 ID        `json:"id"`
+	Type               string    `json:"type"`
+	Purged             bool      `json:"purged"`
+	TargetName         string    `json:"target_name"`
+	FollowersCount     int64     `json:"followers_count"`
+	FollowingCount     int64     `json:"following_count"`
+	RelationshipsCount int64     `json:"relationships_count"`
+	CreatedAt          time.Time `json:"created_at"`
 }
 
+// Convenience constants for PushSubscription.Policy
+const (
+	PushPolicyAll      = "all"
+	PushPolicyFollowed = "followed"
+	PushPolicyFollower = "follower"
+	PushPolicyNone     = "none"
+)
+
 type PushSubscription struct {
 	ID        ID          `json:"id"`
 	Endpoint  string      `json:"endpoint"`
+	Standard  bool        `json:"standard"`
 	ServerKey string      `json:"server_key"`
 	Alerts    *PushAlerts `json:"alerts"`
+	Policy    string      `json:"policy"`
 }
 
 type PushAlerts struct {
-	Follow    *Sbool `json:"follow"`
-	Favourite *Sbool `json:"favourite"`
-	Reblog    *Sbool `json:"reblog"`
-	Mention   *Sbool `json:"mention"`
+	Follow        *Sbool `json:"follow"`
+	Favourite     *Sbool `json:"favourite"`
+	Reblog        *Sbool `json:"reblog"`
+	Mention       *Sbool `json:"mention"`
+	Poll          *Sbool `json:"poll"`
+	Status        *Sbool `json:"status"`
+	Update        *Sbool `json:"update"`
+	FollowRequest *Sbool `json:"follow_request"`
+	AdminSignUp   *Sbool `json:"admin.sign_up"`
+	AdminReport   *Sbool `json:"admin.report"`
+}
+
+func (a *PushAlerts) setValues(params url.Values) {
+	for key, alert := range map[string]*Sbool{
+		"follow":         a.Follow,
+		"favourite":      a.Favourite,
+		"reblog":         a.Reblog,
+		"mention":        a.Mention,
+		"poll":           a.Poll,
+		"status":         a.Status,
+		"update":         a.Update,
+		"follow_request": a.FollowRequest,
+		"admin.sign_up":  a.AdminSignUp,
+		"admin.report":   a.AdminReport,
+	} {
+		if alert != nil {
+			params.Add("data[alerts]["+key+"]", strconv.FormatBool(bool(*alert)))
+		}
+	}
+}
+
+// PushSubscriptionParams specifies the alerts and policy of a push subscription.
+type PushSubscriptionParams struct {
+	Alerts PushAlerts
+	// Policy is one of all, followed, follower and none. Empty keeps the server default.
+	Policy string
+	// Standard requests messages encrypted with the aes128gcm content encoding
+	// instead of the legacy aesgcm one. It is only used when subscribing.
+	Standard bool
+}
+
+func (p *PushSubscriptionParams) toValues() url.Values {
+	params := url.Values{}
+	p.Alerts.setValues(params)
+	if p.Policy != "" {
+		params.Set("data[policy]", p.Policy)
+	}
+	return params
 }
 
 // NotificationFilter customizes how a notification query is submitted to
@@ -130,28 +228,24 @@ func (c *Client) ClearNotifications(ctx context.Context) error {
 
 // AddPushSubscription adds a new push subscription.
 func (c *Client) AddPushSubscription(ctx context.Context, endpoint string, public ecdsa.PublicKey, shared []byte, alerts PushAlerts) (*PushSubscription, error) {
+	return c.AddPushSubscriptionWithParams(ctx, endpoint, public, shared, &PushSubscriptionParams{Alerts: alerts})
+}
+
+// AddPushSubscriptionWithParams adds a new push subscription with the alerts and policy of params.
+func (c *Client) AddPushSubscriptionWithParams(ctx context.Context, endpoint string, public ecdsa.PublicKey, shared []byte, params *PushSubscriptionParams) (*PushSubscription, error) {
 	var subscription PushSubscription
 	pk, err := public.ECDH()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve ecdh public key: %w", err)
 	}
-	params := url.Values{}
-	params.Add("subscription[endpoint]", endpoint)
-	params.Add("subscription[keys][p256dh]", base64.RawURLEncoding.EncodeToString(pk.Bytes()))
-	params.Add("subscription[keys][auth]", base64.RawURLEncoding.EncodeToString(shared))
-	if alerts.Follow != nil {
-		params.Add("data[alerts][follow]", strconv.FormatBool(bool(*alerts.Follow)))
-	}
-	if alerts.Favourite != nil {
-		params.Add("data[alerts][favourite]", strconv.FormatBool(bool(*alerts.Favourite)))
-	}
-	if alerts.Reblog != nil {
-		params.Add("data[alerts][reblog]", strconv.FormatBool(bool(*alerts.Reblog)))
-	}
-	if alerts.Mention != nil {
-		params.Add("data[alerts][mention]", strconv.FormatBool(bool(*alerts.Mention)))
+	values := params.toValues()
+	values.Add("subscription[endpoint]", endpoint)
+	values.Add("subscription[keys][p256dh]", base64.RawURLEncoding.EncodeToString(pk.Bytes()))
+	values.Add("subscription[keys][auth]", base64.RawURLEncoding.EncodeToString(shared))
+	if params.Standard {
+		values.Add("subscription[standard]", "true")
 	}
-	err = c.doAPI(ctx, http.MethodPost, "/api/v1/push/subscription", params, &subscription, nil)
+	err = c.doAPI(ctx, http.MethodPost, "/api/v1/push/subscription", values, &subscription, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,21 +254,13 @@ func (c *Client) AddPushSubscription(ctx context.Context, endpoint string, publi
 
 // UpdatePushSubscription updates which type of notifications are sent for the active push subscription.
 func (c *Client) UpdatePushSubscription(ctx context.Context, alerts *PushAlerts) (*PushSubscription, error) {
+	return c.UpdatePushSubscriptionWithParams(ctx, &PushSubscriptionParams{Alerts: *alerts})
+}
+
+// UpdatePushSubscriptionWithParams updates the alerts and policy of the active push subscription.
+func (c *Client) UpdatePushSubscriptionWithParams(ctx context.Context, params *PushSubscriptionParams) (*PushSubscription, error) {
 	var subscription PushSubscription
-	params := url.Values{}
-	if alerts.Follow != nil {
-		params.Add("data[alerts][follow]", strconv.FormatBool(bool(*alerts.Follow)))
-	}
-	if alerts.Favourite != nil {
-		params.Add("data[alerts][favourite]", strconv.FormatBool(bool(*alerts.Favourite)))
-	}
-	if alerts.Reblog != nil {
-		params.Add("data[alerts][reblog]", strconv.FormatBool(bool(*alerts.Reblog)))
-	}
-	if alerts.Mention != nil {
-		params.Add("data[alerts][mention]", strconv.FormatBool(bool(*alerts.Mention)))
-	}
-	err := c.doAPI(ctx, http.MethodPut, "/api/v1/push/subscription", params, &subscription, nil)
+	err := c.doAPI(ctx, http.MethodPut, "/api/v1/push/subscription", params.toValues(), &subscription, nil)
 	if err != nil {
 		return nil, err
 	}
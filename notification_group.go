@@ -0,0 +1,199 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Convenience constants for GroupedNotificationFilter.ExpandAccounts
+const (
+	ExpandAccountsFull           = "full"
+	ExpandAccountsPartialAvatars = "partial_avatars"
+)
+
+// GroupedNotificationFilter customizes which notification groups are returned
+// by GetGroupedNotifications.
+type GroupedNotificationFilter struct {
+	Includes []string // list of notifications types to include
+	Excludes []string // list of notifications types to exclude
+	// GroupedTypes restricts grouping to these notification types.
+	// Empty groups every type the server supports.
+	GroupedTypes []string
+	// AccountID restricts the notifications to the ones from an account.
+	AccountID ID
+	// ExpandAccounts is one of full and partial_avatars.
+	ExpandAccounts string
+	// IncludeFiltered includes the notifications filtered by the notification policy.
+	IncludeFiltered bool
+}
+
+func (f *GroupedNotificationFilter) toValues() url.Values {
+	params := url.Values{}
+	if f == nil {
+		return params
+	}
+	for _, typ := range f.Includes {
+		params.Add("types[]", typ)
+	}
+	for _, ex := range f.Excludes {
+		params.Add("exclude_types[]", ex)
+	}
+	for _, typ := range f.GroupedTypes {
+		params.Add("grouped_types[]", typ)
+	}
+	if f.AccountID != "" {
+		params.Set("account_id", string(f.AccountID))
+	}
+	if f.ExpandAccounts != "" {
+		params.Set("expand_accounts", f.ExpandAccounts)
+	}
+	if f.IncludeFiltered {
+		params.Set("include_filtered", "true")
+	}
+	return params
+}
+
+// GroupedNotificationsResults holds a page of notification groups with the
+// accounts and statuses they reference.
+type GroupedNotificationsResults struct {
+	Accounts           []*Account                  `json:"accounts"`
+	PartialAccounts    []*PartialAccountWithAvatar `json:"partial_accounts"`
+	Statuses           []*Status                   `json:"statuses"`
+	NotificationGroups []*NotificationGroup        `json:"notification_groups"`
+}
+
+// Account returns the side-loaded account of id, or nil.
+func (r *GroupedNotificationsResults) Account(id ID) *Account {
+	for _, a := range r.Accounts {
+		if a.ID == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// Status returns the side-loaded status of id, or nil.
+func (r *GroupedNotificationsResults) Status(id ID) *Status {
+	for _, s := range r.Statuses {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// PartialAccountWithAvatar holds the subset of an account returned with
+// ExpandAccountsPartialAvatars.
+type PartialAccountWithAvatar struct {
+	ID           ID     `json:"id"`
+	Acct         string `json:"acct"`
+	URL          string `json:"url"`
+	Avatar       string `json:"avatar"`
+	AvatarStatic string `json:"avatar_static"`
+	Locked       bool   `json:"locked"`
+	Bot          bool   `json:"bot"`
+}
+
+// NotificationGroup holds notifications of the same type about the same status.
+type NotificationGroup struct {
+	GroupKey                 string    `json:"group_key"`
+	NotificationsCount       int64     `json:"notifications_count"`
+	Type                     string    `json:"type"`
+	MostRecentNotificationID ID        `json:"most_recent_notification_id"`
+	PageMinID                ID        `json:"page_min_id"`
+	PageMaxID                ID        `json:"page_max_id"`
+	LatestPageNotificationAt time.Time `json:"latest_page_notification_at"`
+	SampleAccountIDs         []ID      `json:"sample_account_ids"`
+	StatusID                 ID        `json:"status_id"`
+	// Report is set for the admin.report type.
+	Report *Report `json:"report"`
+	// Event is set for the severed_relationships type.
+	Event *RelationshipSeveranceEvent `json:"event"`
+	// ModerationWarning is set for the moderation_warning type.
+	ModerationWarning *AccountWarning `json:"moderation_warning"`
+}
+
+// GroupedNotifications iterates over pages of notification groups.
+func (c *Client) GroupedNotifications(ctx context.Context, filter *GroupedNotificationFilter, pg *Pagination) iter.Seq2[*GroupedNotificationsResults, error] {
+	return func(yield func(*GroupedNotificationsResults, error) bool) {
+		var zero Pagination
+		if pg == nil {
+			pg = &Pagination{}
+		}
+		for {
+			results, err := c.GetGroupedNotifications(ctx, filter, pg)
+			if err != nil {
+				_ = yield(nil, err)
+				return
+			}
+
+			if !yield(results, nil) {
+				return
+			}
+
+			if *pg == zero || len(results.NotificationGroups) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// GetGroupedNotifications returns a page of notification groups.
+func (c *Client) GetGroupedNotifications(ctx context.Context, filter *GroupedNotificationFilter, pg *Pagination) (*GroupedNotificationsResults, error) {
+	var results GroupedNotificationsResults
+	err := c.doAPI(ctx, http.MethodGet, "/api/v2/notifications", filter.toValues(), &results, pg)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// GetNotificationGroup returns the notification group of groupKey.
+func (c *Client) GetNotificationGroup(ctx context.Context, groupKey string) (*GroupedNotificationsResults, error) {
+	var results GroupedNotificationsResults
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v2/notifications/%s", url.PathEscape(groupKey)), nil, &results, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// GetNotificationGroupAccounts returns all the accounts of the notification group of groupKey.
+func (c *Client) GetNotificationGroupAccounts(ctx context.Context, groupKey string) ([]*Account, error) {
+	var accounts []*Account
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v2/notifications/%s/accounts", url.PathEscape(groupKey)), nil, &accounts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// DismissNotificationGroup deletes all the notifications of the group of groupKey.
+func (c *Client) DismissNotificationGroup(ctx context.Context, groupKey string) error {
+	return c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v2/notifications/%s/dismiss", url.PathEscape(groupKey)), nil, nil, nil)
+}
+
+// GetGroupedNotificationsUnreadCount returns the number of unread notification
+// groups, capped by limit when it is positive.
+func (c *Client) GetGroupedNotificationsUnreadCount(ctx context.Context, filter *GroupedNotificationFilter, limit int64) (int64, error) {
+	params := filter.toValues()
+	params.Del("expand_accounts")
+	params.Del("include_filtered")
+	if limit > 0 {
+		params.Set("limit", strconv.FormatInt(limit, 10))
+	}
+
+	var count struct {
+		Count int64 `json:"count"`
+	}
+	err := c.doAPI(ctx, http.MethodGet, "/api/v2/notifications/unread_count", params, &count, nil)
+	if err != nil {
+		return 0, err
+	}
+	return count.Count, nil
+}
@@ -0,0 +1,142 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetGroupedNotifications(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/notifications" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("grouped_types[]") != "favourite" || q.Get("expand_accounts") != "partial_avatars" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		switch q.Get("max_id") {
+		case "":
+			w.Header().Set("Link", `<http://example.com?max_id=20>; rel="next"`)
+			fmt.Fprintln(w, `{
+				"accounts": [{"id": "1", "username": "foo"}],
+				"partial_accounts": [{"id": "2", "acct": "bar@example.com", "avatar": "https://example.com/bar.png"}],
+				"statuses": [{"id": "100", "content": "zzz"}],
+				"notification_groups": [{
+					"group_key": "favourite-100-1",
+					"notifications_count": 2,
+					"type": "favourite",
+					"most_recent_notification_id": "21",
+					"page_min_id": "20",
+					"page_max_id": "21",
+					"latest_page_notification_at": "2024-08-29T07:21:37.000Z",
+					"sample_account_ids": ["1", "2"],
+					"status_id": "100"
+				}]
+			}`)
+		case "20":
+			fmt.Fprintln(w, `{"accounts": [], "statuses": [], "notification_groups": []}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	filter := &GroupedNotificationFilter{
+		GroupedTypes:   []string{"favourite"},
+		ExpandAccounts: ExpandAccountsPartialAvatars,
+	}
+	var pages []*GroupedNotificationsResults
+	for results, err := range client.GroupedNotifications(context.Background(), filter, nil) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		pages = append(pages, results)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("result should be two: %d", len(pages))
+	}
+	results := pages[0]
+	if len(results.NotificationGroups) != 1 || len(results.PartialAccounts) != 1 {
+		t.Fatalf("want one group and one partial account but %v", results)
+	}
+	group := results.NotificationGroups[0]
+	if group.GroupKey != "favourite-100-1" || group.NotificationsCount != 2 || len(group.SampleAccountIDs) != 2 {
+		t.Fatalf("want group %q of %d but %v", "favourite-100-1", 2, group)
+	}
+	if status := results.Status(group.StatusID); status == nil || status.Content != "zzz" {
+		t.Fatalf("want status %q but %v", "zzz", status)
+	}
+	if account := results.Account(group.SampleAccountIDs[0]); account == nil || account.Username != "foo" {
+		t.Fatalf("want account %q but %v", "foo", account)
+	}
+	if account := results.Account(group.SampleAccountIDs[1]); account != nil {
+		t.Fatalf("want no full account but %v", account)
+	}
+}
+
+func TestNotificationGroup(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/notifications/favourite-100-1":
+			fmt.Fprintln(w, `{"accounts": [{"id": "1"}], "statuses": [], "notification_groups": [{"group_key": "favourite-100-1", "type": "favourite"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/notifications/favourite-100-1/accounts":
+			fmt.Fprintln(w, `[{"id": "1", "username": "foo"}, {"id": "2", "username": "bar"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/notifications/favourite-100-1/dismiss":
+			fmt.Fprintln(w, `{}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/notifications/unread_count":
+			if r.URL.Query().Get("limit") != "10" || r.URL.Query().Get("types[]") != "mention" {
+				http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintln(w, `{"count": 3}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	results, err := client.GetNotificationGroup(context.Background(), "favourite-100-1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(results.NotificationGroups) != 1 || results.NotificationGroups[0].Type != "favourite" {
+		t.Fatalf("want one %q group but %v", "favourite", results.NotificationGroups)
+	}
+	accounts, err := client.GetNotificationGroupAccounts(context.Background(), "favourite-100-1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("result should be two: %d", len(accounts))
+	}
+	if err := client.DismissNotificationGroup(context.Background(), "favourite-100-1"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DismissNotificationGroup(context.Background(), "mention-1"); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	count, err := client.GetGroupedNotificationsUnreadCount(context.Background(), &GroupedNotificationFilter{Includes: []string{"mention"}}, 10)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if count != 3 {
+		t.Fatalf("want %d but %d", 3, count)
+	}
+}
@@ -0,0 +1,142 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Convenience constants for the fields of NotificationPolicy
+const (
+	NotificationPolicyAccept = "accept"
+	NotificationPolicyFilter = "filter"
+	NotificationPolicyDrop   = "drop"
+)
+
+// NotificationPolicy holds how notifications from strangers are handled.
+// Each field is one of accept, filter and drop.
+type NotificationPolicy struct {
+	ForNotFollowing    string                     `json:"for_not_following"`
+	ForNotFollowers    string                     `json:"for_not_followers"`
+	ForNewAccounts     string                     `json:"for_new_accounts"`
+	ForPrivateMentions string                     `json:"for_private_mentions"`
+	ForLimitedAccounts string                     `json:"for_limited_accounts"`
+	Summary            *NotificationPolicySummary `json:"summary"`
+}
+
+// NotificationPolicySummary holds the number of filtered notifications.
+type NotificationPolicySummary struct {
+	PendingRequestsCount      int64 `json:"pending_requests_count"`
+	PendingNotificationsCount int64 `json:"pending_notifications_count"`
+}
+
+// NotificationRequest holds the filtered notifications from an account.
+type NotificationRequest struct {
+	ID                 ID        `json:"id"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	Account            *Account  `json:"account"`
+	NotificationsCount int64     `json:"notifications_count,string"`
+	LastStatus         *Status   `json:"last_status"`
+}
+
+// GetNotificationPolicy returns the notification policy of the current user.
+func (c *Client) GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error) {
+	var policy NotificationPolicy
+	err := c.doAPI(ctx, http.MethodGet, "/api/v2/notifications/policy", nil, &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// UpdateNotificationPolicy updates the notification policy of the current user.
+// Empty fields of policy are not updated.
+func (c *Client) UpdateNotificationPolicy(ctx context.Context, policy *NotificationPolicy) (*NotificationPolicy, error) {
+	params := url.Values{}
+	if policy.ForNotFollowing != "" {
+		params.Set("for_not_following", policy.ForNotFollowing)
+	}
+	if policy.ForNotFollowers != "" {
+		params.Set("for_not_followers", policy.ForNotFollowers)
+	}
+	if policy.ForNewAccounts != "" {
+		params.Set("for_new_accounts", policy.ForNewAccounts)
+	}
+	if policy.ForPrivateMentions != "" {
+		params.Set("for_private_mentions", policy.ForPrivateMentions)
+	}
+	if policy.ForLimitedAccounts != "" {
+		params.Set("for_limited_accounts", policy.ForLimitedAccounts)
+	}
+
+	var updated NotificationPolicy
+	err := c.doAPI(ctx, http.MethodPatch, "/api/v2/notifications/policy", params, &updated, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// GetNotificationRequests returns the filtered notifications grouped by account.
+func (c *Client) GetNotificationRequests(ctx context.Context, pg *Pagination) ([]*NotificationRequest, error) {
+	var requests []*NotificationRequest
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/notifications/requests", nil, &requests, pg)
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// GetNotificationRequest returns the notification request of id.
+func (c *Client) GetNotificationRequest(ctx context.Context, id ID) (*NotificationRequest, error) {
+	var request NotificationRequest
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/notifications/requests/%s", url.PathEscape(string(id))), nil, &request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// AcceptNotificationRequest moves the notifications of the request of id to the main notifications.
+func (c *Client) AcceptNotificationRequest(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/notifications/requests/%s/accept", url.PathEscape(string(id))), nil, nil, nil)
+}
+
+// DismissNotificationRequest deletes the notifications of the request of id.
+func (c *Client) DismissNotificationRequest(ctx context.Context, id ID) error {
+	return c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/notifications/requests/%s/dismiss", url.PathEscape(string(id))), nil, nil, nil)
+}
+
+// AcceptNotificationRequests accepts the notification requests of ids at once.
+func (c *Client) AcceptNotificationRequests(ctx context.Context, ids ...ID) error {
+	return c.notificationRequestsPost(ctx, "accept", ids)
+}
+
+// DismissNotificationRequests dismisses the notification requests of ids at once.
+func (c *Client) DismissNotificationRequests(ctx context.Context, ids ...ID) error {
+	return c.notificationRequestsPost(ctx, "dismiss", ids)
+}
+
+func (c *Client) notificationRequestsPost(ctx context.Context, action string, ids []ID) error {
+	params := url.Values{}
+	for _, id := range ids {
+		params.Add("id[]", string(id))
+	}
+	return c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/notifications/requests/%s", action), params, nil, nil)
+}
+
+// NotificationRequestsMerged reports whether the notifications of the accepted
+// requests have been merged into the main notifications.
+func (c *Client) NotificationRequestsMerged(ctx context.Context) (bool, error) {
+	var merged struct {
+		Merged bool `json:"merged"`
+	}
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/notifications/requests/merged", nil, &merged, nil)
+	if err != nil {
+		return false, err
+	}
+	return merged.Merged, nil
+}
@@ -0,0 +1,135 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNotificationPolicy(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/notifications/policy" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprintln(w, `{"for_not_following": "accept", "for_not_followers": "accept", "for_new_accounts": "filter", "for_private_mentions": "filter", "for_limited_accounts": "drop", "summary": {"pending_requests_count": 2, "pending_notifications_count": 5}}`)
+		case http.MethodPatch:
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if _, ok := r.PostForm["for_not_following"]; ok || r.PostForm.Get("for_new_accounts") != "drop" {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{"for_not_following": "accept", "for_new_accounts": "drop"}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	policy, err := client.GetNotificationPolicy(context.Background())
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if policy.ForLimitedAccounts != NotificationPolicyDrop || policy.ForNewAccounts != NotificationPolicyFilter {
+		t.Fatalf("want %q, %q but %q, %q", NotificationPolicyDrop, NotificationPolicyFilter, policy.ForLimitedAccounts, policy.ForNewAccounts)
+	}
+	if policy.Summary == nil || policy.Summary.PendingNotificationsCount != 5 {
+		t.Fatalf("want %d pending notifications but %v", 5, policy.Summary)
+	}
+	policy, err = client.UpdateNotificationPolicy(context.Background(), &NotificationPolicy{ForNewAccounts: NotificationPolicyDrop})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if policy.ForNewAccounts != NotificationPolicyDrop {
+		t.Fatalf("want %q but %q", NotificationPolicyDrop, policy.ForNewAccounts)
+	}
+}
+
+func TestNotificationRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/notifications/requests":
+			fmt.Fprintln(w, `[{"id": "1", "account": {"id": "10", "username": "foo"}, "notifications_count": "3", "last_status": {"id": "100"}}, {"id": "2", "notifications_count": "1"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/notifications/requests/1":
+			fmt.Fprintln(w, `{"id": "1", "notifications_count": "3"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/notifications/requests/merged":
+			fmt.Fprintln(w, `{"merged": true}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/notifications/requests/1/accept",
+			r.Method == http.MethodPost && r.URL.Path == "/api/v1/notifications/requests/1/dismiss":
+			fmt.Fprintln(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/notifications/requests/accept",
+			r.Method == http.MethodPost && r.URL.Path == "/api/v1/notifications/requests/dismiss":
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if len(r.PostForm["id[]"]) != 2 {
+				http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+				return
+			}
+			fmt.Fprintln(w, `{}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	requests, err := client.GetNotificationRequests(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("result should be two: %d", len(requests))
+	}
+	if requests[0].NotificationsCount != 3 || requests[0].Account.Username != "foo" || requests[0].LastStatus.ID != "100" {
+		t.Fatalf("want %d notifications from %q but %v", 3, "foo", requests[0])
+	}
+	request, err := client.GetNotificationRequest(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if request.ID != "1" {
+		t.Fatalf("want %q but %q", "1", request.ID)
+	}
+	if err := client.AcceptNotificationRequest(context.Background(), "1"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DismissNotificationRequest(context.Background(), "1"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DismissNotificationRequest(context.Background(), "2"); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	if err := client.AcceptNotificationRequests(context.Background(), "1", "2"); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := client.DismissNotificationRequests(context.Background(), "1"); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	merged, err := client.NotificationRequestsMerged(context.Background())
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !merged {
+		t.Fatalf("want %t but %t", true, merged)
+	}
+}
@@ -276,3 +276,112 @@ func TestUpdatePushSubscriptionPartialAlerts(t *testing.T) {
 		t.Fatalf("want %v but %v", "true", got)
 	}
 }
+
+func TestPushSubscriptionWithParams(t *testing.T) {
+	var form url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/push/subscription" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		form = r.PostForm
+		fmt.Fprintln(w, `{"id":1,"endpoint":"https://example.org","standard":true,"alerts":{"poll":true,"admin.sign_up":true,"admin.report":false},"policy":"followed","server_key":"foobar"}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+
+	keys, err := GeneratePushKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled, disabled := Sbool(true), Sbool(false)
+	params := &PushSubscriptionParams{
+		Alerts:   PushAlerts{Poll: &enabled, AdminSignUp: &enabled, AdminReport: &disabled},
+		Policy:   PushPolicyFollowed,
+		Standard: true,
+	}
+	sub, err := client.AddPushSubscriptionWithParams(context.Background(), "https://example.org", keys.PrivateKey.PublicKey, keys.Auth, params)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if form.Get("subscription[standard]") != "true" || form.Get("data[policy]") != "followed" {
+		t.Fatalf("want standard subscription with policy %q but %v", "followed", form)
+	}
+	if form.Get("data[alerts][admin.sign_up]") != "true" || form.Get("data[alerts][admin.report]") != "false" || form.Has("data[alerts][follow]") {
+		t.Fatalf("want only the set alerts but %v", form)
+	}
+	if !sub.Standard || sub.Policy != PushPolicyFollowed {
+		t.Fatalf("want standard subscription with policy %q but %v, %q", PushPolicyFollowed, sub.Standard, sub.Policy)
+	}
+	if !bool(*sub.Alerts.Poll) || !bool(*sub.Alerts.AdminSignUp) || bool(*sub.Alerts.AdminReport) {
+		t.Fatalf("want poll and admin.sign_up alerts but %v", sub.Alerts)
+	}
+
+	_, err = client.UpdatePushSubscriptionWithParams(context.Background(), params)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if form.Has("subscription[standard]") || form.Get("data[policy]") != "followed" || form.Get("data[alerts][poll]") != "true" {
+		t.Fatalf("want policy and alerts only but %v", form)
+	}
+}
+
+func TestNotificationPayloads(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/notifications" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `[
+			{"id": "1", "type": "admin.report", "group_key": "ungrouped-1", "account": {"id": "10"}, "report": {"id": "5", "category": "spam", "action_taken_at": null, "target_account": {"id": "11"}}},
+			{"id": "2", "type": "severed_relationships", "group_key": "ungrouped-2", "account": {"id": "10"}, "event": {"id": "6", "type": "domain_block", "purged": false, "target_name": "example.com", "followers_count": 2, "following_count": 1, "relationships_count": 3}},
+			{"id": "3", "type": "moderation_warning", "group_key": "ungrouped-3", "account": {"id": "10"}, "moderation_warning": {"id": "7", "action": "silence", "text": "spam"}},
+			{"id": "4", "type": "favourite", "group_key": "favourite-100-1", "account": {"id": "10"}, "status": {"id": "100"}}
+		]`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	ns, err := client.GetNotifications(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(ns) != 4 {
+		t.Fatalf("result should be four: %d", len(ns))
+	}
+	for _, n := range ns {
+		switch n.Type {
+		case NotificationTypeAdminReport:
+			if n.Report == nil || n.Report.Category != ReportCategorySpam || n.Report.TargetAccount.ID != "11" {
+				t.Fatalf("want %q report against %q but %v", ReportCategorySpam, "11", n.Report)
+			}
+		case NotificationTypeSeveredRelationships:
+			if n.Event == nil || n.Event.Type != RelationshipSeveranceDomainBlock || n.Event.RelationshipsCount != 3 {
+				t.Fatalf("want %q event of %d relationships but %v", RelationshipSeveranceDomainBlock, 3, n.Event)
+			}
+		case NotificationTypeModerationWarning:
+			if n.ModerationWarning == nil || n.ModerationWarning.Action != AccountWarningActionSilence {
+				t.Fatalf("want %q warning but %v", AccountWarningActionSilence, n.ModerationWarning)
+			}
+		case NotificationTypeFavourite:
+			if n.GroupKey != "favourite-100-1" || n.Status == nil {
+				t.Fatalf("want grouped favourite but %q, %v", n.GroupKey, n.Status)
+			}
+		default:
+			t.Fatalf("unexpected notification type %q", n.Type)
+		}
+	}
+}
@@ -2,10 +2,13 @@ package mastodon
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"time"
+	"unicode/utf8"
 )
 
 // Poll holds information for mastodon polls.
@@ -26,6 +29,208 @@ type Poll struct {
 type PollOption struct {
 	Title      string `json:"title"`
 	VotesCount int64  `json:"votes_count"`
+	// Hidden is set when the server withholds the votes count, which happens
+	// until a poll created with hide_totals expires.
+	Hidden bool `json:"-"`
+}
+
+func (o *PollOption) UnmarshalJSON(b []byte) error {
+	type pollOption PollOption
+	var v struct {
+		pollOption
+		VotesCount *int64 `json:"votes_count"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*o = PollOption(v.pollOption)
+	o.Hidden = v.VotesCount == nil
+	if v.VotesCount != nil {
+		o.VotesCount = *v.VotesCount
+	}
+	return nil
+}
+
+// TotalsHidden reports whether the votes of the options are withheld.
+func (p *Poll) TotalsHidden() bool {
+	for _, opt := range p.Options {
+		if opt.Hidden {
+			return true
+		}
+	}
+	return false
+}
+
+// Percentages returns the share of the votes of each option, between 0 and 100.
+// Multiple choice polls are relative to the voters, so the shares may add up to
+// more than 100. It reports false when the totals are hidden.
+func (p *Poll) Percentages() ([]float64, bool) {
+	if p.TotalsHidden() {
+		return nil, false
+	}
+	total := p.VotesCount
+	if p.Multiple && p.VotersCount > 0 {
+		total = p.VotersCount
+	}
+	percentages := make([]float64, len(p.Options))
+	if total == 0 {
+		return percentages, true
+	}
+	for i, opt := range p.Options {
+		percentages[i] = float64(opt.VotesCount) * 100 / float64(total)
+	}
+	return percentages, true
+}
+
+// LeadingOptions returns the indices of the options with the most votes, which
+// is empty when nobody voted. It reports false when the totals are hidden.
+func (p *Poll) LeadingOptions() ([]int, bool) {
+	if p.TotalsHidden() {
+		return nil, false
+	}
+	var leading []int
+	var most int64
+	for i, opt := range p.Options {
+		switch {
+		case opt.VotesCount == 0:
+		case opt.VotesCount > most:
+			most = opt.VotesCount
+			leading = []int{i}
+		case opt.VotesCount == most:
+			leading = append(leading, i)
+		}
+	}
+	return leading, true
+}
+
+// Convenience constants for PollValidationError.Field
+const (
+	PollFieldOptions      = "options"
+	PollFieldOptionLength = "option_length"
+	PollFieldExpiration   = "expiration"
+	PollFieldChoices      = "choices"
+	PollFieldChoice       = "choice"
+)
+
+// PollsConfig holds the limits of an instance for polls.
+type PollsConfig struct {
+	MaxOptions             int64 `json:"max_options"`
+	MaxCharactersPerOption int64 `json:"max_characters_per_option"`
+	MinExpiration          int64 `json:"min_expiration"`
+	MaxExpiration          int64 `json:"max_expiration"`
+}
+
+// PollsConfig returns the typed poll limits of the instance.
+func (c *InstanceConfig) PollsConfig() (*PollsConfig, error) {
+	var cfg PollsConfig
+	if c.Polls == nil {
+		return &cfg, nil
+	}
+	if err := decodeInstanceConfig(*c.Polls, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// PollValidationError is returned when a poll or a vote is out of the allowed range.
+type PollValidationError struct {
+	// Field is one of options, option_length, expiration, choices and choice.
+	Field string
+	Value int64
+	Min   int64
+	Max   int64
+}
+
+func (e *PollValidationError) Error() string {
+	return fmt.Sprintf("poll %s of %d is out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
+
+// Check returns a *PollValidationError if poll exceeds the limits.
+// Zero limits are unknown and are not checked. Option lengths are counted in
+// runes, which may differ from the server for some emoji sequences.
+func (c *PollsConfig) Check(poll *TootPoll) error {
+	// Mastodon requires at least two options regardless of the configuration.
+	if n := int64(len(poll.Options)); n < 2 || (c.MaxOptions > 0 && n > c.MaxOptions) {
+		return &PollValidationError{Field: PollFieldOptions, Value: n, Min: 2, Max: c.MaxOptions}
+	}
+	if c.MaxCharactersPerOption > 0 {
+		for _, opt := range poll.Options {
+			if n := int64(utf8.RuneCountInString(opt)); n > c.MaxCharactersPerOption {
+				return &PollValidationError{Field: PollFieldOptionLength, Value: n, Min: 0, Max: c.MaxCharactersPerOption}
+			}
+		}
+	}
+	if e := poll.ExpiresInSeconds; (c.MinExpiration > 0 && e < c.MinExpiration) || (c.MaxExpiration > 0 && e > c.MaxExpiration) {
+		return &PollValidationError{Field: PollFieldExpiration, Value: e, Min: c.MinExpiration, Max: c.MaxExpiration}
+	}
+	return nil
+}
+
+// ValidatePoll checks poll against the poll limits of the instance before it is posted.
+func (c *Client) ValidatePoll(ctx context.Context, poll *TootPoll) error {
+	instance, err := c.GetInstance(ctx)
+	if err != nil {
+		return err
+	}
+	if instance.Configuration == nil {
+		return nil
+	}
+	cfg, err := instance.Configuration.PollsConfig()
+	if err != nil {
+		return err
+	}
+	return cfg.Check(poll)
+}
+
+// CheckChoices returns an error if choices is not a valid vote on the poll.
+func (p *Poll) CheckChoices(choices ...int) error {
+	if p.Expired {
+		return errors.New("poll has expired")
+	}
+	maxChoices := int64(1)
+	if p.Multiple {
+		maxChoices = int64(len(p.Options))
+	}
+	if n := int64(len(choices)); n < 1 || n > maxChoices {
+		return &PollValidationError{Field: PollFieldChoices, Value: n, Min: 1, Max: maxChoices}
+	}
+	seen := make(map[int]bool, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(p.Options) {
+			return &PollValidationError{Field: PollFieldChoice, Value: int64(choice), Min: 0, Max: int64(len(p.Options) - 1)}
+		}
+		if seen[choice] {
+			return fmt.Errorf("poll choice %d is given twice", choice)
+		}
+		seen[choice] = true
+	}
+	return nil
+}
+
+// pollWatchInterval is the delay between polls which should have expired but are not yet closed.
+var pollWatchInterval = time.Second
+
+// WaitForPoll waits until the poll of id expires and returns its final results.
+func (c *Client) WaitForPoll(ctx context.Context, id ID) (*Poll, error) {
+	for {
+		poll, err := c.GetPoll(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if poll.Expired {
+			return poll, nil
+		}
+		if poll.ExpiresAt.IsZero() {
+			return nil, errors.New("poll never expires")
+		}
+
+		wait := max(time.Until(poll.ExpiresAt), pollWatchInterval)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 }
 
 // GetPoll returns poll specified by id.
@@ -38,10 +243,17 @@ func (c *Client) GetPoll(ctx context.Context, id ID) (*Poll, error) {
 	return &poll, nil
 }
 
-// PollVote votes on a poll specified by id, choices is the Poll.Options index to vote on
+// PollVote votes on a poll specified by id, choices is the Poll.Options index to vote on.
+// Use Poll.CheckChoices to validate choices against the poll beforehand.
 func (c *Client) PollVote(ctx context.Context, id ID, choices ...int) (*Poll, error) {
+	if len(choices) == 0 {
+		return nil, errors.New("no poll choices")
+	}
 	params := url.Values{}
 	for _, c := range choices {
+		if c < 0 {
+			return nil, fmt.Errorf("invalid poll choice %d", c)
+		}
 		params.Add("choices[]", fmt.Sprintf("%d", c))
 	}
 
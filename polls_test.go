@@ -2,10 +2,14 @@ package mastodon
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetPoll(t *testing.T) {
@@ -143,3 +147,181 @@ func TestPollVote(t *testing.T) {
 		t.Fatalf("want %q but %q", 4, poll.Options[1].VotesCount)
 	}
 }
+
+func TestPollResults(t *testing.T) {
+	var poll Poll
+	err := json.Unmarshal([]byte(`{"id": "1", "expired": false, "multiple": false, "votes_count": 10, "options": [{"title": "accept", "votes_count": null}, {"title": "deny", "votes_count": null}]}`), &poll)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !poll.TotalsHidden() || !poll.Options[0].Hidden {
+		t.Fatalf("want hidden totals but %v", poll.Options)
+	}
+	if _, ok := poll.Percentages(); ok {
+		t.Fatalf("want no percentages of hidden totals")
+	}
+	if _, ok := poll.LeadingOptions(); ok {
+		t.Fatalf("want no leading options of hidden totals")
+	}
+
+	err = json.Unmarshal([]byte(`{"id": "1", "expired": true, "multiple": true, "votes_count": 12, "voters_count": 8, "options": [{"title": "a", "votes_count": 6}, {"title": "b", "votes_count": 2}, {"title": "c", "votes_count": 4}, {"title": "d", "votes_count": 0}]}`), &poll)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	percentages, ok := poll.Percentages()
+	if !ok {
+		t.Fatalf("want percentages")
+	}
+	if want := []float64{75, 25, 50, 0}; !reflect.DeepEqual(percentages, want) {
+		t.Fatalf("want %v but %v", want, percentages)
+	}
+	leading, ok := poll.LeadingOptions()
+	if !ok || !reflect.DeepEqual(leading, []int{0}) {
+		t.Fatalf("want %v but %v", []int{0}, leading)
+	}
+
+	poll.Options[2].VotesCount = 6
+	leading, _ = poll.LeadingOptions()
+	if !reflect.DeepEqual(leading, []int{0, 2}) {
+		t.Fatalf("want %v but %v", []int{0, 2}, leading)
+	}
+	poll = Poll{Options: []PollOption{{Title: "a"}, {Title: "b"}}}
+	percentages, _ = poll.Percentages()
+	leading, _ = poll.LeadingOptions()
+	if !reflect.DeepEqual(percentages, []float64{0, 0}) || len(leading) != 0 {
+		t.Fatalf("want no votes but %v, %v", percentages, leading)
+	}
+}
+
+func TestPollsConfigCheck(t *testing.T) {
+	cfg := &PollsConfig{MaxOptions: 4, MaxCharactersPerOption: 5, MinExpiration: 300, MaxExpiration: 2629746}
+	tests := []struct {
+		poll  TootPoll
+		field string
+	}{
+		{TootPoll{Options: []string{"a", "b"}, ExpiresInSeconds: 300}, ""},
+		{TootPoll{Options: []string{"a"}, ExpiresInSeconds: 300}, PollFieldOptions},
+		{TootPoll{Options: []string{"a", "b", "c", "d", "e"}, ExpiresInSeconds: 300}, PollFieldOptions},
+		{TootPoll{Options: []string{"a", "ねこねこねこ"}, ExpiresInSeconds: 300}, PollFieldOptionLength},
+		{TootPoll{Options: []string{"a", "ねこねこね"}, ExpiresInSeconds: 60}, PollFieldExpiration},
+		{TootPoll{Options: []string{"a", "b"}, ExpiresInSeconds: 2629747}, PollFieldExpiration},
+	}
+	for _, test := range tests {
+		err := cfg.Check(&test.poll)
+		if test.field == "" {
+			if err != nil {
+				t.Fatalf("should not be fail: %v", err)
+			}
+			continue
+		}
+		var perr *PollValidationError
+		if !errors.As(err, &perr) || perr.Field != test.field {
+			t.Fatalf("want %q error but %v", test.field, err)
+		}
+	}
+	if err := (&PollsConfig{}).Check(&TootPoll{Options: []string{"a", "b"}}); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+}
+
+func TestValidatePoll(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/instance" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"uri": "zzz", "configuration": {"polls": {"max_options": 4, "max_characters_per_option": 50, "min_expiration": 300, "max_expiration": 2629746}}}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	err := client.ValidatePoll(context.Background(), &TootPoll{Options: []string{"a", "b"}, ExpiresInSeconds: 3600})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	err = client.ValidatePoll(context.Background(), &TootPoll{Options: []string{"a", "b", "c", "d", "e"}, ExpiresInSeconds: 3600})
+	var perr *PollValidationError
+	if !errors.As(err, &perr) || perr.Field != PollFieldOptions || perr.Max != 4 {
+		t.Fatalf("want %q error with max %d but %v", PollFieldOptions, 4, err)
+	}
+}
+
+func TestPollCheckChoices(t *testing.T) {
+	poll := &Poll{Options: []PollOption{{Title: "a"}, {Title: "b"}, {Title: "c"}}}
+	if err := poll.CheckChoices(1); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	for _, choices := range [][]int{nil, {0, 1}, {3}, {-1}} {
+		if err := poll.CheckChoices(choices...); err == nil {
+			t.Fatalf("should be fail for %v", choices)
+		}
+	}
+	poll.Multiple = true
+	if err := poll.CheckChoices(0, 2); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if err := poll.CheckChoices(0, 0); err == nil {
+		t.Fatalf("should be fail for duplicate choices")
+	}
+	poll.Expired = true
+	if err := poll.CheckChoices(0); err == nil {
+		t.Fatalf("should be fail for expired poll")
+	}
+
+	client := NewClient(&Config{Server: "http://example.invalid"})
+	if _, err := client.PollVote(context.Background(), "1"); err == nil {
+		t.Fatalf("should be fail without choices")
+	}
+	if _, err := client.PollVote(context.Background(), "1", -1); err == nil {
+		t.Fatalf("should be fail for negative choice")
+	}
+}
+
+func TestWaitForPoll(t *testing.T) {
+	defer func(d time.Duration) { pollWatchInterval = d }(pollWatchInterval)
+	pollWatchInterval = time.Millisecond
+
+	expiresAt := time.Now().Add(20 * time.Millisecond).UTC().Format(time.RFC3339Nano)
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/polls/1":
+			calls++
+			expired := calls > 2
+			fmt.Fprintf(w, `{"id": "1", "expires_at": %q, "expired": %t, "votes_count": 3, "options": [{"title": "a", "votes_count": 3}]}`, expiresAt, expired)
+		case "/api/v1/polls/2":
+			fmt.Fprintln(w, `{"id": "2", "expires_at": null, "expired": false, "options": []}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	poll, err := client.WaitForPoll(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !poll.Expired || calls != 3 {
+		t.Fatalf("want expired poll after %d calls but %t after %d", 3, poll.Expired, calls)
+	}
+	if _, err := client.WaitForPoll(context.Background(), "2"); err == nil {
+		t.Fatalf("should be fail for a poll without expiration")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	if _, err := client.WaitForPoll(ctx, "1"); err == nil {
+		t.Fatalf("should be fail for a canceled context")
+	}
+}
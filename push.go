@@ -0,0 +1,245 @@
+package mastodon
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PushKeys holds the keys of a Web Push subscription, as registered with
+// AddPushSubscription(ctx, endpoint, keys.PrivateKey.PublicKey, keys.Auth, alerts).
+type PushKeys struct {
+	PrivateKey *ecdsa.PrivateKey
+	Auth       []byte
+}
+
+// PushNotification holds the decrypted payload of a Web Push message.
+// The full notification is returned by GetNotification(ctx, n.NotificationID).
+type PushNotification struct {
+	AccessToken      string `json:"access_token"`
+	PreferredLocale  string `json:"preferred_locale"`
+	NotificationID   ID     `json:"notification_id"`
+	NotificationType string `json:"notification_type"`
+	Icon             string `json:"icon"`
+	Title            string `json:"title"`
+	Body             string `json:"body"`
+}
+
+// GeneratePushKeys generates a P-256 keypair and a 16 bytes auth secret for a push subscription.
+func GeneratePushKeys() (*PushKeys, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		return nil, err
+	}
+	return &PushKeys{PrivateKey: priv, Auth: auth}, nil
+}
+
+// DecryptNotification decrypts a Web Push message received with header and
+// body, and decodes the notification it contains.
+func (k *PushKeys) DecryptNotification(header http.Header, body []byte) (*PushNotification, error) {
+	payload, err := k.Decrypt(header, body)
+	if err != nil {
+		return nil, err
+	}
+	var notification PushNotification
+	if err := json.Unmarshal(payload, &notification); err != nil {
+		return nil, fmt.Errorf("could not decode push notification: %w", err)
+	}
+	return &notification, nil
+}
+
+// Decrypt decrypts a Web Push message received with header and body.
+// Both the aes128gcm (RFC 8291) and the legacy aesgcm content encodings are supported.
+func (k *PushKeys) Decrypt(header http.Header, body []byte) ([]byte, error) {
+	switch enc := strings.ToLower(header.Get("Content-Encoding")); enc {
+	case "aes128gcm":
+		return k.decryptAES128GCM(body)
+	case "aesgcm":
+		return k.decryptAESGCM(header, body)
+	default:
+		return nil, fmt.Errorf("unsupported push content encoding: %q", enc)
+	}
+}
+
+func (k *PushKeys) ecdh(senderKey []byte) (secret, public []byte, err error) {
+	priv, err := k.PrivateKey.ECDH()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not retrieve ecdh private key: %w", err)
+	}
+	sender, err := ecdh.P256().NewPublicKey(senderKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid push sender key: %w", err)
+	}
+	secret, err = priv.ECDH(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secret, priv.PublicKey().Bytes(), nil
+}
+
+func (k *PushKeys) decryptAES128GCM(body []byte) ([]byte, error) {
+	// salt (16) | record size (4) | key id length (1) | key id
+	if len(body) < 21 {
+		return nil, errors.New("push message too short")
+	}
+	salt := body[:16]
+	rs := int(binary.BigEndian.Uint32(body[16:20]))
+	idlen := int(body[20])
+	if len(body) < 21+idlen || rs <= 17 {
+		return nil, errors.New("invalid push message header")
+	}
+	senderKey := body[21 : 21+idlen]
+	ciphertext := body[21+idlen:]
+
+	secret, public, err := k.ecdh(senderKey)
+	if err != nil {
+		return nil, err
+	}
+	info := append(append([]byte("WebPush: info\x00"), public...), senderKey...)
+	ikm, err := hkdf.Key(sha256.New, secret, k.Auth, string(info), 32)
+	if err != nil {
+		return nil, err
+	}
+	gcm, nonce, err := pushCipher(ikm, salt, "Content-Encoding: aes128gcm\x00", "Content-Encoding: nonce\x00")
+	if err != nil {
+		return nil, err
+	}
+
+	var payload []byte
+	for seq := uint64(0); len(ciphertext) > 0; seq++ {
+		n := min(rs, len(ciphertext))
+		record, err := gcm.Open(nil, recordNonce(nonce, seq), ciphertext[:n], nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt push message: %w", err)
+		}
+		ciphertext = ciphertext[n:]
+
+		// data | delimiter | zero padding
+		i := len(record) - 1
+		for i >= 0 && record[i] == 0 {
+			i--
+		}
+		delimiter := byte(1)
+		if len(ciphertext) == 0 {
+			delimiter = 2
+		}
+		if i < 0 || record[i] != delimiter {
+			return nil, errors.New("invalid push message padding")
+		}
+		payload = append(payload, record[:i]...)
+	}
+	return payload, nil
+}
+
+func (k *PushKeys) decryptAESGCM(header http.Header, body []byte) ([]byte, error) {
+	salt, err := pushHeaderParam(header.Get("Encryption"), "salt")
+	if err != nil {
+		return nil, err
+	}
+	senderKey, err := pushHeaderParam(header.Get("Crypto-Key"), "dh")
+	if err != nil {
+		return nil, err
+	}
+
+	secret, public, err := k.ecdh(senderKey)
+	if err != nil {
+		return nil, err
+	}
+	ikm, err := hkdf.Key(sha256.New, secret, k.Auth, "Content-Encoding: auth\x00", 32)
+	if err != nil {
+		return nil, err
+	}
+	keyContext := []byte("P-256\x00")
+	keyContext = binary.BigEndian.AppendUint16(keyContext, uint16(len(public)))
+	keyContext = append(keyContext, public...)
+	keyContext = binary.BigEndian.AppendUint16(keyContext, uint16(len(senderKey)))
+	keyContext = append(keyContext, senderKey...)
+	gcm, nonce, err := pushCipher(ikm, salt, "Content-Encoding: aesgcm\x00"+string(keyContext), "Content-Encoding: nonce\x00"+string(keyContext))
+	if err != nil {
+		return nil, err
+	}
+
+	// Records are 4096 bytes of plaintext, each prefixed by a two bytes padding length.
+	const rs = 4096 + 16
+	var payload []byte
+	for seq := uint64(0); len(body) > 0; seq++ {
+		n := min(rs, len(body))
+		record, err := gcm.Open(nil, recordNonce(nonce, seq), body[:n], nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt push message: %w", err)
+		}
+		body = body[n:]
+
+		if len(record) < 2 {
+			return nil, errors.New("invalid push message padding")
+		}
+		pad := int(binary.BigEndian.Uint16(record))
+		if len(record) < 2+pad {
+			return nil, errors.New("invalid push message padding")
+		}
+		payload = append(payload, record[2+pad:]...)
+	}
+	return payload, nil
+}
+
+func pushCipher(ikm, salt []byte, keyInfo, nonceInfo string) (cipher.AEAD, []byte, error) {
+	cek, err := hkdf.Key(sha256.New, ikm, salt, keyInfo, 16)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, nonceInfo, 12)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return gcm, nonce, nil
+}
+
+// recordNonce XORs the sequence number of a record into the low bytes of nonce.
+func recordNonce(nonce []byte, seq uint64) []byte {
+	n := bytes.Clone(nonce)
+	tail := binary.BigEndian.Uint64(n[4:]) ^ seq
+	binary.BigEndian.PutUint64(n[4:], tail)
+	return n
+}
+
+// pushHeaderParam returns the base64url value of name in a header like
+// "keyid=p256dh;dh=BNoR...;p256ecdsa=BDd3...".
+func pushHeaderParam(header, name string) ([]byte, error) {
+	for _, field := range strings.FieldsFunc(header, func(r rune) bool { return r == ';' || r == ',' }) {
+		k, v, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok || k != name {
+			continue
+		}
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.Trim(v, `"`), "="))
+		if err != nil {
+			return nil, fmt.Errorf("could not decode push %s: %w", name, err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("missing push %s", name)
+}
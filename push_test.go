@@ -0,0 +1,206 @@
+package mastodon
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func mustDecodeBase64(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// TestPushKeysDecryptRFC8291 decrypts the example message of RFC 8291 Appendix A.
+func TestPushKeysDecryptRFC8291(t *testing.T) {
+	priv, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), mustDecodeBase64(t, "q1dXpw3UpT5VOmu_cf_v6ih07Aems3njxI-JWgLcM94"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := &PushKeys{PrivateKey: priv, Auth: mustDecodeBase64(t, "BTBZMqHH6r4Tts7J_aSIgg")}
+	body := mustDecodeBase64(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN")
+
+	header := http.Header{}
+	header.Set("Content-Encoding", "aes128gcm")
+	payload, err := keys.Decrypt(header, body)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if got, want := string(payload), "When I grow up, I want to be a watermelon"; got != want {
+		t.Fatalf("want %q but %q", want, got)
+	}
+
+	body[len(body)-1] ^= 1
+	if _, err := keys.Decrypt(header, body); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	header.Set("Content-Encoding", "gzip")
+	if _, err := keys.Decrypt(header, body); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
+
+// encryptPush encrypts payload for keys as a push service would, with the
+// aes128gcm content encoding and records of rs bytes.
+func encryptPush(t *testing.T, keys *PushKeys, payload []byte, rs int) []byte {
+	t.Helper()
+	sender, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver, err := keys.PrivateKey.PublicKey.ECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := sender.ECDH(receiver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		t.Fatal(err)
+	}
+	info := append(append([]byte("WebPush: info\x00"), receiver.Bytes()...), sender.PublicKey().Bytes()...)
+	ikm, err := hkdf.Key(sha256.New, secret, keys.Auth, string(info), 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, nonce := testPushCipher(t, ikm, salt, "Content-Encoding: aes128gcm\x00", "Content-Encoding: nonce\x00")
+
+	body := append([]byte{}, salt...)
+	body = binary.BigEndian.AppendUint32(body, uint32(rs))
+	body = append(body, byte(len(sender.PublicKey().Bytes())))
+	body = append(body, sender.PublicKey().Bytes()...)
+	chunk := rs - 17
+	for seq := uint64(0); ; seq++ {
+		n := min(chunk, len(payload))
+		record := append([]byte{}, payload[:n]...)
+		payload = payload[n:]
+		if len(payload) == 0 {
+			record = append(record, 2)
+			body = gcm.Seal(body, recordNonce(nonce, seq), record, nil)
+			return body
+		}
+		record = append(record, 1)
+		body = gcm.Seal(body, recordNonce(nonce, seq), record, nil)
+	}
+}
+
+// encryptPushLegacy encrypts payload for keys with the aesgcm content encoding.
+func encryptPushLegacy(t *testing.T, keys *PushKeys, payload []byte) (http.Header, []byte) {
+	t.Helper()
+	sender, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver, err := keys.PrivateKey.PublicKey.ECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := sender.ECDH(receiver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		t.Fatal(err)
+	}
+	ikm, err := hkdf.Key(sha256.New, secret, keys.Auth, "Content-Encoding: auth\x00", 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyContext := []byte("P-256\x00")
+	keyContext = binary.BigEndian.AppendUint16(keyContext, 65)
+	keyContext = append(keyContext, receiver.Bytes()...)
+	keyContext = binary.BigEndian.AppendUint16(keyContext, 65)
+	keyContext = append(keyContext, sender.PublicKey().Bytes()...)
+	gcm, nonce := testPushCipher(t, ikm, salt, "Content-Encoding: aesgcm\x00"+string(keyContext), "Content-Encoding: nonce\x00"+string(keyContext))
+
+	record := append([]byte{0, 3, 0, 0, 0}, payload...)
+	header := http.Header{}
+	header.Set("Content-Encoding", "aesgcm")
+	header.Set("Encryption", "salt="+base64.RawURLEncoding.EncodeToString(salt))
+	header.Set("Crypto-Key", fmt.Sprintf("dh=%s;p256ecdsa=BDd3_hVL9fZi9Ybo2UUzA284WG5FZR30_95YeZJsiApwXKpNcF1rRPF3foIiBHXRdJI2Qhumhf6_LFTeZaNndIo", base64.RawURLEncoding.EncodeToString(sender.PublicKey().Bytes())))
+	return header, gcm.Seal(nil, recordNonce(nonce, 0), record, nil)
+}
+
+func testPushCipher(t *testing.T, ikm, salt []byte, keyInfo, nonceInfo string) (cipher.AEAD, []byte) {
+	t.Helper()
+	cek, err := hkdf.Key(sha256.New, ikm, salt, keyInfo, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, nonceInfo, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gcm, nonce
+}
+
+func TestPushKeysDecryptNotification(t *testing.T) {
+	keys, err := GeneratePushKeys()
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(keys.Auth) != 16 {
+		t.Fatalf("want %d bytes auth but %d", 16, len(keys.Auth))
+	}
+	payload := []byte(`{"access_token": "zoo", "preferred_locale": "en", "notification_id": 123, "notification_type": "mention", "icon": "https://example.com/avatar.png", "title": "foo mentioned you", "body": "hello"}`)
+
+	header := http.Header{}
+	header.Set("Content-Encoding", "aes128gcm")
+	for _, rs := range []int{4096, 32} {
+		n, err := keys.DecryptNotification(header, encryptPush(t, keys, payload, rs))
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		if n.NotificationID != "123" || n.NotificationType != NotificationTypeMention || n.Body != "hello" {
+			t.Fatalf("want %q %q with %q but %v", "123", NotificationTypeMention, "hello", n)
+		}
+	}
+
+	header, body := encryptPushLegacy(t, keys, payload)
+	n, err := keys.DecryptNotification(header, body)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if n.Title != "foo mentioned you" {
+		t.Fatalf("want %q but %q", "foo mentioned you", n.Title)
+	}
+	header.Del("Encryption")
+	if _, err := keys.DecryptNotification(header, body); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+
+	other, err := GeneratePushKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header = http.Header{}
+	header.Set("Content-Encoding", "aes128gcm")
+	if _, err := other.DecryptNotification(header, encryptPush(t, keys, payload, 4096)); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
@@ -4,12 +4,61 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
+)
+
+// Convenience constants for the category of a report
+const (
+	ReportCategorySpam      = "spam"
+	ReportCategoryLegal     = "legal"
+	ReportCategoryViolation = "violation"
+	ReportCategoryOther     = "other"
 )
 
 // Report holds information for a mastodon report.
 type Report struct {
-	ID          ID   `json:"id"`
-	ActionTaken bool `json:"action_taken"`
+	ID            ID         `json:"id"`
+	ActionTaken   bool       `json:"action_taken"`
+	ActionTakenAt *time.Time `json:"action_taken_at"`
+	Category      string     `json:"category"`
+	Comment       string     `json:"comment"`
+	Forwarded     bool       `json:"forwarded"`
+	CreatedAt     time.Time  `json:"created_at"`
+	StatusIDs     []ID       `json:"status_ids"`
+	RuleIDs       []ID       `json:"rule_ids"`
+	TargetAccount *Account   `json:"target_account"`
+}
+
+// ReportParams specifies a report filed by ReportWithParams.
+type ReportParams struct {
+	AccountID ID
+	StatusIDs []ID
+	Comment   string
+	// Forward sends a copy of the report to the instance of a remote account.
+	Forward bool
+	// Category is one of spam, legal, violation and other.
+	Category string
+	// RuleIDs are the violated rules of the instance, used with the violation category.
+	RuleIDs []ID
+}
+
+func (p *ReportParams) toValues() url.Values {
+	params := url.Values{}
+	params.Set("account_id", string(p.AccountID))
+	for _, id := range p.StatusIDs {
+		params.Add("status_ids[]", string(id))
+	}
+	params.Set("comment", p.Comment)
+	if p.Forward {
+		params.Set("forward", "true")
+	}
+	if p.Category != "" {
+		params.Set("category", p.Category)
+	}
+	for _, id := range p.RuleIDs {
+		params.Add("rule_ids[]", string(id))
+	}
+	return params
 }
 
 // GetReports returns report of the current user.
@@ -24,14 +73,17 @@ func (c *Client) GetReports(ctx context.Context) ([]*Report, error) {
 
 // Report reports the report
 func (c *Client) Report(ctx context.Context, accountID ID, ids []ID, comment string) (*Report, error) {
-	params := url.Values{}
-	params.Set("account_id", string(accountID))
-	for _, id := range ids {
-		params.Add("status_ids[]", string(id))
-	}
-	params.Set("comment", comment)
+	return c.ReportWithParams(ctx, &ReportParams{
+		AccountID: accountID,
+		StatusIDs: ids,
+		Comment:   comment,
+	})
+}
+
+// ReportWithParams reports an account with the category, rules and forwarding of params.
+func (c *Client) ReportWithParams(ctx context.Context, params *ReportParams) (*Report, error) {
 	var report Report
-	err := c.doAPI(ctx, http.MethodPost, "/api/v1/reports", params, &report, nil)
+	err := c.doAPI(ctx, http.MethodPost, "/api/v1/reports", params.toValues(), &report, nil)
 	if err != nil {
 		return nil, err
 	}
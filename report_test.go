@@ -88,3 +88,53 @@ func TestReport(t *testing.T) {
 		t.Fatalf("want %v but %v", false, rp.ActionTaken)
 	}
 }
+
+func TestReportWithParams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/reports" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("category") != "violation" || r.PostForm.Get("forward") != "true" || len(r.PostForm["rule_ids[]"]) != 2 || len(r.PostForm["status_ids[]"]) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprintln(w, `{"id": "1234", "action_taken": false, "category": "violation", "forwarded": true, "rule_ids": ["1", "3"], "target_account": {"id": "122"}}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.ReportWithParams(context.Background(), &ReportParams{
+		AccountID: "122",
+		Category:  ReportCategorySpam,
+	})
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	rp, err := client.ReportWithParams(context.Background(), &ReportParams{
+		AccountID: "122",
+		StatusIDs: []ID{"567"},
+		Comment:   "rude",
+		Forward:   true,
+		Category:  ReportCategoryViolation,
+		RuleIDs:   []ID{"1", "3"},
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if rp.Category != ReportCategoryViolation || !rp.Forwarded {
+		t.Fatalf("want forwarded %q but %q, %v", ReportCategoryViolation, rp.Category, rp.Forwarded)
+	}
+	if len(rp.RuleIDs) != 2 || rp.TargetAccount == nil || rp.TargetAccount.ID != "122" {
+		t.Fatalf("want two rules against %q but %v", "122", rp)
+	}
+}
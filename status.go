@@ -3,13 +3,14 @@ package mastodon
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
+	"iter"
 	"net/http"
 	"net/url"
 	"os"
-	"strings"
 	"time"
 )
 
@@ -19,20 +20,21 @@ type Status struct {
 	URI                string          `json:"uri"`
 	URL                string          `json:"url"`
 	Account            Account         `json:"account"`
-	InReplyToID        interface{}     `json:"in_reply_to_id"`
-	InReplyToAccountID interface{}     `json:"in_reply_to_account_id"`
+	InReplyToID        *ID             `json:"in_reply_to_id"`
+	InReplyToAccountID *ID             `json:"in_reply_to_account_id"`
 	Reblog             *Status         `json:"reblog"`
 	Content            string          `json:"content"`
+	Text               string          `json:"text"`
 	CreatedAt          time.Time       `json:"created_at"`
-	EditedAt           time.Time       `json:"edited_at"`
+	EditedAt           *time.Time      `json:"edited_at"`
 	Emojis             []Emoji         `json:"emojis"`
 	RepliesCount       int64           `json:"replies_count"`
 	ReblogsCount       int64           `json:"reblogs_count"`
 	FavouritesCount    int64           `json:"favourites_count"`
-	Reblogged          interface{}     `json:"reblogged"`
-	Favourited         interface{}     `json:"favourited"`
-	Bookmarked         interface{}     `json:"bookmarked"`
-	Muted              interface{}     `json:"muted"`
+	Reblogged          *bool           `json:"reblogged"`
+	Favourited         *bool           `json:"favourited"`
+	Bookmarked         *bool           `json:"bookmarked"`
+	Muted              *bool           `json:"muted"`
 	Sensitive          bool            `json:"sensitive"`
 	SpoilerText        string          `json:"spoiler_text"`
 	Visibility         string          `json:"visibility"`
@@ -43,9 +45,51 @@ type Status struct {
 	Poll               *Poll           `json:"poll"`
 	Application        Application     `json:"application"`
 	Language           string          `json:"language"`
-	Pinned             interface{}     `json:"pinned"`
+	Pinned             *bool           `json:"pinned"`
 	ScheduledParams    ScheduledParams `json:"params"`
 	Filtered           []FilterResult  `json:"filtered"`
+	Quote              *Quote          `json:"quote"`
+}
+
+// UnmarshalJSON decodes a status, accepting numbers for the IDs and strings
+// for the booleans as sent by some servers.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	type status Status
+	v := struct {
+		*status
+		Reblogged  *Sbool `json:"reblogged"`
+		Favourited *Sbool `json:"favourited"`
+		Bookmarked *Sbool `json:"bookmarked"`
+		Muted      *Sbool `json:"muted"`
+		Pinned     *Sbool `json:"pinned"`
+	}{status: (*status)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	s.Reblogged = v.Reblogged.ptr()
+	s.Favourited = v.Favourited.ptr()
+	s.Bookmarked = v.Bookmarked.ptr()
+	s.Muted = v.Muted.ptr()
+	s.Pinned = v.Pinned.ptr()
+	return nil
+}
+
+// Convenience constants for Quote.State
+const (
+	QuoteStatePending      = "pending"
+	QuoteStateAccepted     = "accepted"
+	QuoteStateRejected     = "rejected"
+	QuoteStateRevoked      = "revoked"
+	QuoteStateDeleted      = "deleted"
+	QuoteStateUnauthorized = "unauthorized"
+)
+
+// Quote holds the status quoted by a status.
+// QuotedStatus is nil unless State is accepted; nested quotes only carry QuotedStatusID.
+type Quote struct {
+	State          string  `json:"state"`
+	QuotedStatus   *Status `json:"quoted_status"`
+	QuotedStatusID ID      `json:"quoted_status_id"`
 }
 
 // StatusHistory is a struct to hold status history data.
@@ -61,16 +105,16 @@ type StatusHistory struct {
 
 // ScheduledStatus holds information returned when ScheduledAt is set on a status
 type ScheduledParams struct {
-	ApplicationID ID          `json:"application_id"`
-	Idempotency   string      `json:"idempotency"`
-	InReplyToID   interface{} `json:"in_reply_to_id"`
-	MediaIDs      []ID        `json:"media_ids"`
-	Poll          *Poll       `json:"poll"`
-	ScheduledAt   *time.Time  `json:"scheduled_at,omitempty"`
-	Sensitive     bool        `json:"sensitive"`
-	SpoilerText   string      `json:"spoiler_text"`
-	Text          string      `json:"text"`
-	Visibility    string      `json:"visibility"`
+	ApplicationID ID         `json:"application_id"`
+	Idempotency   string     `json:"idempotency"`
+	InReplyToID   *ID        `json:"in_reply_to_id"`
+	MediaIDs      []ID       `json:"media_ids"`
+	Poll          *Poll      `json:"poll"`
+	ScheduledAt   *time.Time `json:"scheduled_at,omitempty"`
+	Sensitive     bool       `json:"sensitive"`
+	SpoilerText   string     `json:"spoiler_text"`
+	Text          string     `json:"text"`
+	Visibility    string     `json:"visibility"`
 }
 
 // Context holds information for a mastodon context.
@@ -102,6 +146,34 @@ type Source struct {
 	SpoilerText string `json:"spoiler_text"`
 }
 
+// Translation holds the machine translation of a status.
+type Translation struct {
+	Content                string                  `json:"content"`
+	SpoilerText            string                  `json:"spoiler_text"`
+	Poll                   *TranslationPoll        `json:"poll"`
+	MediaAttachments       []TranslationAttachment `json:"media_attachments"`
+	DetectedSourceLanguage string                  `json:"detected_source_language"`
+	Language               string                  `json:"language"`
+	Provider               string                  `json:"provider"`
+}
+
+// TranslationPoll holds the translated options of a poll.
+type TranslationPoll struct {
+	ID      ID                  `json:"id"`
+	Options []TranslationOption `json:"options"`
+}
+
+// TranslationOption holds the translated title of a poll option.
+type TranslationOption struct {
+	Title string `json:"title"`
+}
+
+// TranslationAttachment holds the translated description of a media attachment.
+type TranslationAttachment struct {
+	ID          ID     `json:"id"`
+	Description string `json:"description"`
+}
+
 // Conversation holds information for a mastodon conversation.
 type Conversation struct {
 	ID         ID         `json:"id"`
@@ -110,77 +182,12 @@ type Conversation struct {
 	LastStatus *Status    `json:"last_status"`
 }
 
-// Media is struct to hold media.
-type Media struct {
-	File        io.Reader
-	Thumbnail   io.Reader
-	Description string
-	Focus       string
-}
-
 type TagData struct {
 	Any  []string
 	All  []string
 	None []string
 }
 
-func (m *Media) bodyAndContentType() (io.Reader, string, error) {
-	var buf bytes.Buffer
-	mw := multipart.NewWriter(&buf)
-
-	fileName := "upload"
-	if f, ok := m.File.(*os.File); ok {
-		fileName = f.Name()
-	}
-	file, err := mw.CreateFormFile("file", fileName)
-	if err != nil {
-		return nil, "", err
-	}
-	if _, err := io.Copy(file, m.File); err != nil {
-		return nil, "", err
-	}
-
-	if m.Thumbnail != nil {
-		thumbName := "upload"
-		if f, ok := m.Thumbnail.(*os.File); ok {
-			thumbName = f.Name()
-		}
-		thumb, err := mw.CreateFormFile("thumbnail", thumbName)
-		if err != nil {
-			return nil, "", err
-		}
-		if _, err := io.Copy(thumb, m.Thumbnail); err != nil {
-			return nil, "", err
-		}
-	}
-
-	if m.Description != "" {
-		desc, err := mw.CreateFormField("description")
-		if err != nil {
-			return nil, "", err
-		}
-		if _, err := io.Copy(desc, strings.NewReader(m.Description)); err != nil {
-			return nil, "", err
-		}
-	}
-
-	if m.Focus != "" {
-		focus, err := mw.CreateFormField("focus")
-		if err != nil {
-			return nil, "", err
-		}
-		if _, err := io.Copy(focus, strings.NewReader(m.Focus)); err != nil {
-			return nil, "", err
-		}
-	}
-
-	if err := mw.Close(); err != nil {
-		return nil, "", err
-	}
-
-	return &buf, mw.FormDataContentType(), nil
-}
-
 // GetFavourites returns the favorite list of the current user.
 func (c *Client) GetFavourites(ctx context.Context, pg *Pagination) ([]*Status, error) {
 	var statuses []*Status
@@ -331,6 +338,62 @@ func (c *Client) Unbookmark(ctx context.Context, id ID) (*Status, error) {
 	return &status, nil
 }
 
+// Pin pins the toot of id to the profile of the current user and returns status of the pinned toot.
+func (c *Client) Pin(ctx context.Context, id ID) (*Status, error) {
+	var status Status
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/statuses/%s/pin", id), nil, &status, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// Unpin unpins the toot of id from the profile of the current user and returns status of the unpinned toot.
+func (c *Client) Unpin(ctx context.Context, id ID) (*Status, error) {
+	var status Status
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/statuses/%s/unpin", id), nil, &status, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// MuteConversation mutes notifications for the conversation of the toot of id.
+func (c *Client) MuteConversation(ctx context.Context, id ID) (*Status, error) {
+	var status Status
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/statuses/%s/mute", id), nil, &status, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// UnmuteConversation unmutes notifications for the conversation of the toot of id.
+func (c *Client) UnmuteConversation(ctx context.Context, id ID) (*Status, error) {
+	var status Status
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/statuses/%s/unmute", id), nil, &status, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// TranslateStatus translates the toot of id into lang.
+// If lang is empty, the server translates into the language of the current user.
+func (c *Client) TranslateStatus(ctx context.Context, id ID, lang string) (*Translation, error) {
+	params := url.Values{}
+	if lang != "" {
+		params.Set("lang", lang)
+	}
+
+	var translation Translation
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/statuses/%s/translate", id), params, &translation, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &translation, nil
+}
+
 // GetTimelineHome return statuses from home timeline.
 func (c *Client) GetTimelineHome(ctx context.Context, pg *Pagination) ([]*Status, error) {
 	var statuses []*Status
@@ -502,19 +565,127 @@ func (c *Client) DeleteStatus(ctx context.Context, id ID) error {
 	return c.doAPI(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/statuses/%s", id), nil, nil, nil)
 }
 
+// Convenience constants for SearchParams.Type
+const (
+	SearchTypeAccounts = "accounts"
+	SearchTypeHashtags = "hashtags"
+	SearchTypeStatuses = "statuses"
+)
+
+// SearchParams is a struct for specifying a search query.
+// See:
+//
+//	https://docs.joinmastodon.org/methods/search/#v2
+type SearchParams struct {
+	Query string
+	// Type is one of accounts, hashtags and statuses.
+	// If it is empty, all types are searched.
+	Type              string
+	Resolve           bool
+	Following         bool
+	AccountID         ID
+	ExcludeUnreviewed bool
+	MaxID             ID
+	MinID             ID
+	Limit             int64
+	// Offset skips the first results. It requires Type to be set.
+	Offset int64
+}
+
+func (p *SearchParams) toValues() url.Values {
+	params := url.Values{}
+	params.Set("q", p.Query)
+	params.Set("resolve", fmt.Sprint(p.Resolve))
+	if p.Type != "" {
+		params.Set("type", p.Type)
+	}
+	if p.Following {
+		params.Set("following", "true")
+	}
+	if p.AccountID != "" {
+		params.Set("account_id", string(p.AccountID))
+	}
+	if p.ExcludeUnreviewed {
+		params.Set("exclude_unreviewed", "true")
+	}
+	if p.MaxID != "" {
+		params.Set("max_id", string(p.MaxID))
+	}
+	if p.MinID != "" {
+		params.Set("min_id", string(p.MinID))
+	}
+	if p.Limit > 0 {
+		params.Set("limit", fmt.Sprint(p.Limit))
+	}
+	if p.Offset > 0 {
+		params.Set("offset", fmt.Sprint(p.Offset))
+	}
+	return params
+}
+
 // Search search content with query.
 func (c *Client) Search(ctx context.Context, q string, resolve bool) (*Results, error) {
-	params := url.Values{}
-	params.Set("q", q)
-	params.Set("resolve", fmt.Sprint(resolve))
+	return c.SearchWithParams(ctx, &SearchParams{Query: q, Resolve: resolve})
+}
+
+// SearchWithParams search content with the given parameters.
+func (c *Client) SearchWithParams(ctx context.Context, params *SearchParams) (*Results, error) {
 	var results Results
-	err := c.doAPI(ctx, http.MethodGet, "/api/v2/search", params, &results, nil)
+	err := c.doAPI(ctx, http.MethodGet, "/api/v2/search", params.toValues(), &results, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &results, nil
 }
 
+// SearchAccounts iterates over the accounts matching params.
+// params.Type and params.Offset are managed by the iterator.
+func (c *Client) SearchAccounts(ctx context.Context, params *SearchParams) iter.Seq2[*Account, error] {
+	return searchResults(ctx, c, params, SearchTypeAccounts, func(r *Results) []*Account { return r.Accounts })
+}
+
+// SearchStatuses iterates over the statuses matching params.
+// params.Type and params.Offset are managed by the iterator.
+func (c *Client) SearchStatuses(ctx context.Context, params *SearchParams) iter.Seq2[*Status, error] {
+	return searchResults(ctx, c, params, SearchTypeStatuses, func(r *Results) []*Status { return r.Statuses })
+}
+
+// SearchHashtags iterates over the hashtags matching params.
+// params.Type and params.Offset are managed by the iterator.
+func (c *Client) SearchHashtags(ctx context.Context, params *SearchParams) iter.Seq2[*Tag, error] {
+	return searchResults(ctx, c, params, SearchTypeHashtags, func(r *Results) []*Tag { return r.Hashtags })
+}
+
+// searchResults pages through the results of a single type by offset, since
+// the search endpoint does not return a Link header.
+func searchResults[T any](ctx context.Context, c *Client, params *SearchParams, typ string, pick func(*Results) []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		p := *params
+		p.Type = typ
+		for {
+			results, err := c.SearchWithParams(ctx, &p)
+			if err != nil {
+				_ = yield(zero, err)
+				return
+			}
+
+			vs := pick(results)
+			for _, v := range vs {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			// The server caps limit, so a short page does not mean the last one.
+			if len(vs) == 0 {
+				return
+			}
+			p.Offset += int64(len(vs))
+		}
+	}
+}
+
 // UploadMedia upload a media attachment from a file.
 func (c *Client) UploadMedia(ctx context.Context, file string) (*Attachment, error) {
 	f, err := os.Open(file)
@@ -537,20 +708,90 @@ func (c *Client) UploadMediaFromReader(ctx context.Context, reader io.Reader) (*
 }
 
 // UploadMediaFromMedia uploads a media attachment from a Media struct.
+//
+// Large files are processed asynchronously by the server. In that case the
+// returned attachment has an empty URL; use WaitForMedia to wait until it is ready.
 func (c *Client) UploadMediaFromMedia(ctx context.Context, media *Media) (*Attachment, error) {
 	var attachment Attachment
-	// "/api/v2/media" USING V2 TODO: IMPORTANT
 	if err := c.doAPI(ctx, http.MethodPost, "/api/v2/media", media, &attachment, nil); err != nil {
 		return nil, err
 	}
 	return &attachment, nil
 }
 
+// UploadMediaAndWait uploads a media attachment from a Media struct and waits
+// until the server has finished processing it.
+func (c *Client) UploadMediaAndWait(ctx context.Context, media *Media) (*Attachment, error) {
+	attachment, err := c.UploadMediaFromMedia(ctx, media)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.URL != "" {
+		return attachment, nil
+	}
+	return c.WaitForMedia(ctx, attachment.ID)
+}
+
 // GetMediaStatus checks the status of a media attachment.
 func (c *Client) GetMediaStatus(ctx context.Context, attachment *Attachment) error {
 	return c.doAPI(ctx, http.MethodGet, "/api/v1/media/"+url.PathEscape(string(attachment.ID)), nil, nil, nil)
 }
 
+// GetMedia returns the media attachment of id.
+// While the server is still processing the attachment, the returned error
+// is an *APIError with StatusCode http.StatusPartialContent.
+func (c *Client) GetMedia(ctx context.Context, id ID) (*Attachment, error) {
+	var attachment Attachment
+	err := c.doAPI(ctx, http.MethodGet, "/api/v1/media/"+url.PathEscape(string(id)), nil, &attachment, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// mediaPollInterval is the initial interval between checks in WaitForMedia.
+var mediaPollInterval = time.Second
+
+// WaitForMedia polls the media attachment of id with an exponential backoff
+// until the server has finished processing it or ctx is done.
+func (c *Client) WaitForMedia(ctx context.Context, id ID) (*Attachment, error) {
+	backoff := mediaPollInterval
+	for {
+		attachment, err := c.GetMedia(ctx, id)
+		if err == nil {
+			return attachment, nil
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusPartialContent {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if backoff < time.Minute {
+			backoff = time.Duration(1.5 * float64(backoff))
+		}
+	}
+}
+
+// UpdateMedia updates the description, focus or thumbnail of the media
+// attachment of id. Media.File is ignored.
+func (c *Client) UpdateMedia(ctx context.Context, id ID, media *Media) (*Attachment, error) {
+	m := *media
+	m.File = nil
+
+	var attachment Attachment
+	err := c.doAPI(ctx, http.MethodPut, "/api/v1/media/"+url.PathEscape(string(id)), &m, &attachment, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
 // GetTimelineDirect return statuses from direct timeline.
 func (c *Client) GetTimelineDirect(ctx context.Context, pg *Pagination) ([]*Status, error) {
 	params := url.Values{}
@@ -3,12 +3,14 @@ package mastodon
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetFavourites(t *testing.T) {
@@ -711,6 +713,141 @@ func TestUnbookmark(t *testing.T) {
 	}
 }
 
+func TestPinUnpin(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/statuses/1234567/pin":
+			fmt.Fprintln(w, `{"content": "zzz", "pinned": true}`)
+		case "/api/v1/statuses/1234567/unpin":
+			fmt.Fprintln(w, `{"content": "zzz", "pinned": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.Pin(context.Background(), "123")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	status, err := client.Pin(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.Pinned == nil || *status.Pinned != true {
+		t.Fatalf("want %v but %v", true, status.Pinned)
+	}
+	status, err = client.Unpin(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.Pinned == nil || *status.Pinned != false {
+		t.Fatalf("want %v but %v", false, status.Pinned)
+	}
+}
+
+func TestMuteUnmuteConversation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/statuses/1234567/mute":
+			fmt.Fprintln(w, `{"content": "zzz", "muted": true}`)
+		case "/api/v1/statuses/1234567/unmute":
+			fmt.Fprintln(w, `{"content": "zzz", "muted": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.MuteConversation(context.Background(), "123")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	status, err := client.MuteConversation(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.Muted == nil || *status.Muted != true {
+		t.Fatalf("want %v but %v", true, status.Muted)
+	}
+	status, err = client.UnmuteConversation(context.Background(), "1234567")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.Muted == nil || *status.Muted != false {
+		t.Fatalf("want %v but %v", false, status.Muted)
+	}
+}
+
+func TestTranslateStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/statuses/1234567/translate" || r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("lang") != "en" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `{"content": "<p>Hello</p>", "spoiler_text": "", "poll": {"id": "34", "options": [{"title": "Yes"}, {"title": "No"}]}, "media_attachments": [{"id": "22", "description": "A cat"}], "detected_source_language": "ja", "language": "en", "provider": "DeepL.com"}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.TranslateStatus(context.Background(), "123", "en")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	translation, err := client.TranslateStatus(context.Background(), "1234567", "en")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if translation.Content != "<p>Hello</p>" {
+		t.Fatalf("want %q but %q", "<p>Hello</p>", translation.Content)
+	}
+	if translation.DetectedSourceLanguage != "ja" {
+		t.Fatalf("want %q but %q", "ja", translation.DetectedSourceLanguage)
+	}
+	if translation.Provider != "DeepL.com" {
+		t.Fatalf("want %q but %q", "DeepL.com", translation.Provider)
+	}
+	if translation.Poll == nil || len(translation.Poll.Options) != 2 || translation.Poll.Options[1].Title != "No" {
+		t.Fatalf("poll options should be translated: %v", translation.Poll)
+	}
+	if len(translation.MediaAttachments) != 1 || translation.MediaAttachments[0].Description != "A cat" {
+		t.Fatalf("media descriptions should be translated: %v", translation.MediaAttachments)
+	}
+}
+
 func TestGetTimelinePublic(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Query().Get("local") == "" {
@@ -1017,6 +1154,112 @@ func TestSearch(t *testing.T) {
 	}
 }
 
+func TestSearchWithParams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/search" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if r.RequestURI != "/api/v2/search?account_id=123&exclude_unreviewed=true&following=true&limit=5&max_id=99&q=q&resolve=true&type=statuses" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, `{"accounts":[], "statuses":[{"content": "aaa"}], "hashtags":[]}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	ret, err := client.SearchWithParams(context.Background(), &SearchParams{
+		Query:             "q",
+		Type:              SearchTypeStatuses,
+		Resolve:           true,
+		Following:         true,
+		AccountID:         "123",
+		ExcludeUnreviewed: true,
+		MaxID:             "99",
+		Limit:             5,
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if len(ret.Statuses) != 1 {
+		t.Fatalf("Statuses have %q entries, but %q", "1", len(ret.Statuses))
+	}
+}
+
+func TestSearchStatuses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/search" || r.URL.Query().Get("type") != "statuses" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprintln(w, `{"statuses":[{"content": "aaa"},{"content": "bbb"}]}`)
+		case "2":
+			fmt.Fprintln(w, `{"statuses":[{"content": "ccc"}]}`)
+		case "3":
+			fmt.Fprintln(w, `{"statuses":[]}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	var contents []string
+	for status, err := range client.SearchStatuses(context.Background(), &SearchParams{Query: "q", Limit: 2}) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		contents = append(contents, status.Content)
+	}
+	if got, want := strings.Join(contents, ","), "aaa,bbb,ccc"; got != want {
+		t.Fatalf("want %q but %q", want, got)
+	}
+
+	// The server returns fewer results than a limit above its cap.
+	contents = nil
+	for status, err := range client.SearchStatuses(context.Background(), &SearchParams{Query: "q", Limit: 100}) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		contents = append(contents, status.Content)
+	}
+	if got, want := strings.Join(contents, ","), "aaa,bbb,ccc"; got != want {
+		t.Fatalf("want %q but %q", want, got)
+	}
+}
+
+func TestSearchAccountsError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	for _, err := range client.SearchAccounts(context.Background(), &SearchParams{Query: "q"}) {
+		if err == nil {
+			t.Fatalf("should be fail: %v", err)
+		}
+	}
+}
+
 func TestUploadMedia(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "POST" {
@@ -1096,6 +1339,96 @@ func TestGetMediaStatus(t *testing.T) {
 	}
 }
 
+func TestUploadMediaAndWait(t *testing.T) {
+	interval := mediaPollInterval
+	mediaPollInterval = time.Millisecond
+	defer func() { mediaPollInterval = interval }()
+
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/media":
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintln(w, `{"id": "123", "type": "video", "url": null}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/media/123":
+			polls++
+			if polls < 3 {
+				w.WriteHeader(http.StatusPartialContent)
+				fmt.Fprintln(w, `{"id": "123", "type": "video", "url": null}`)
+				return
+			}
+			fmt.Fprintln(w, `{"id": "123", "type": "video", "url": "https://example.com/123.mp4"}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	attachment, err := client.UploadMediaAndWait(context.Background(), &Media{File: strings.NewReader("video")})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if attachment.URL != "https://example.com/123.mp4" {
+		t.Fatalf("want %q but %q", "https://example.com/123.mp4", attachment.URL)
+	}
+	if polls != 3 {
+		t.Fatalf("want %d polls but %d", 3, polls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForMedia(ctx, "123")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	_, err = client.WaitForMedia(context.Background(), "456")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
+
+func TestUpdateMedia(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/media/123" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.MultipartForm.File["file"]; ok {
+			http.Error(w, "file must not be sent", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"id": "123", "description": %q, "meta": {"focus": {"x": -0.5, "y": 0.25}}}`, r.FormValue("description")+" "+r.FormValue("focus"))
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:      ts.URL,
+		AccessToken: "zoo",
+	})
+	attachment, err := client.UpdateMedia(context.Background(), "123", &Media{
+		File:        strings.NewReader("ignored"),
+		Description: "a cat",
+		Focus:       "-0.5,0.25",
+	})
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if attachment.Description != "a cat -0.5,0.25" {
+		t.Fatalf("want %q but %q", "a cat -0.5,0.25", attachment.Description)
+	}
+	if attachment.Meta.Focus.X != -0.5 {
+		t.Fatalf("want %v but %v", -0.5, attachment.Meta.Focus.X)
+	}
+}
+
 func TestGetConversations(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v1/conversations" {
@@ -1178,3 +1511,49 @@ func TestMarkConversationsAsRead(t *testing.T) {
 		t.Fatalf("should not be fail: %v", err)
 	}
 }
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	var status Status
+	err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"in_reply_to_id": 2,
+		"in_reply_to_account_id": "3",
+		"content": "zzz",
+		"text": "zzz",
+		"edited_at": null,
+		"reblogged": "true",
+		"favourited": false,
+		"bookmarked": 1,
+		"muted": null,
+		"quote": {"state": "accepted", "quoted_status": {"id": "4", "quote": {"state": "accepted", "quoted_status_id": "5"}}}
+	}`), &status)
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.ID != "1" || status.InReplyToID == nil || *status.InReplyToID != "2" || status.InReplyToAccountID == nil || *status.InReplyToAccountID != "3" {
+		t.Fatalf("want %q replying to %q by %q but %q, %v, %v", "1", "2", "3", status.ID, status.InReplyToID, status.InReplyToAccountID)
+	}
+	if status.Reblogged == nil || !*status.Reblogged || status.Favourited == nil || *status.Favourited || status.Bookmarked == nil || !*status.Bookmarked {
+		t.Fatalf("want %v, %v, %v but %v, %v, %v", true, false, true, status.Reblogged, status.Favourited, status.Bookmarked)
+	}
+	if status.Muted != nil || status.Pinned != nil || status.EditedAt != nil {
+		t.Fatalf("want nil but %v, %v, %v", status.Muted, status.Pinned, status.EditedAt)
+	}
+	if status.Text != "zzz" {
+		t.Fatalf("want %q but %q", "zzz", status.Text)
+	}
+	if status.Quote == nil || status.Quote.State != QuoteStateAccepted || status.Quote.QuotedStatus == nil || status.Quote.QuotedStatus.Quote.QuotedStatusID != "5" {
+		t.Fatalf("want quote of %q but %v", "5", status.Quote)
+	}
+
+	status = Status{}
+	if err := json.Unmarshal([]byte(`{"in_reply_to_id": null, "edited_at": "2024-01-02T03:04:05Z", "pinned": true}`), &status); err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if status.InReplyToID != nil || status.EditedAt == nil || status.Pinned == nil || !*status.Pinned {
+		t.Fatalf("want no reply, edited and pinned but %v, %v, %v", status.InReplyToID, status.EditedAt, status.Pinned)
+	}
+	if err := json.Unmarshal([]byte(`{"pinned": "maybe"}`), &status); err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+}
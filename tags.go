@@ -3,14 +3,15 @@ package mastodon
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 )
 
 // TagInfo gets statistics and information about a tag
-func (c *Client) TagInfo(ctx context.Context, tag string) (*FollowedTag, error) {
-	var hashtag FollowedTag
-	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/tags/%s", url.PathEscape(string(tag))), nil, &hashtag, nil)
+func (c *Client) TagInfo(ctx context.Context, tag string) (*Tag, error) {
+	var hashtag Tag
+	err := c.doAPI(ctx, http.MethodGet, fmt.Sprintf("/api/v1/tags/%s", url.PathEscape(tag)), nil, &hashtag, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -18,28 +19,64 @@ func (c *Client) TagInfo(ctx context.Context, tag string) (*FollowedTag, error)
 }
 
 // TagFollow lets you follow a hashtag
-func (c *Client) TagFollow(ctx context.Context, tag string) (*FollowedTag, error) {
-	var hashtag FollowedTag
-	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/tags/%s/follow", url.PathEscape(string(tag))), nil, &hashtag, nil)
+func (c *Client) TagFollow(ctx context.Context, tag string) (*Tag, error) {
+	return c.tagPost(ctx, tag, "follow")
+}
+
+// TagUnfollow unfollows a hashtag.
+func (c *Client) TagUnfollow(ctx context.Context, tag string) (*Tag, error) {
+	return c.tagPost(ctx, tag, "unfollow")
+}
+
+// TagFeature features a hashtag on the profile of the current user.
+func (c *Client) TagFeature(ctx context.Context, tag string) (*Tag, error) {
+	return c.tagPost(ctx, tag, "feature")
+}
+
+// TagUnfeature stops featuring a hashtag on the profile of the current user.
+func (c *Client) TagUnfeature(ctx context.Context, tag string) (*Tag, error) {
+	return c.tagPost(ctx, tag, "unfeature")
+}
+
+func (c *Client) tagPost(ctx context.Context, tag, action string) (*Tag, error) {
+	var hashtag Tag
+	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/tags/%s/%s", url.PathEscape(tag), action), nil, &hashtag, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &hashtag, nil
 }
 
-// TagUnfollow unfollows a hashtag.
-func (c *Client) TagUnfollow(ctx context.Context, ID string) (*FollowedTag, error) {
-	var tag FollowedTag
-	err := c.doAPI(ctx, http.MethodPost, fmt.Sprintf("/api/v1/tags/%s/unfollow", url.PathEscape(ID)), nil, &tag, nil)
-	if err != nil {
-		return nil, err
+// FollowedTags iterates over the hashtags you follow, page by page.
+func (c *Client) FollowedTags(ctx context.Context, pg *Pagination) iter.Seq2[*Tag, error] {
+	return func(yield func(*Tag, error) bool) {
+		var zero Pagination
+		if pg == nil {
+			pg = &Pagination{}
+		}
+		for {
+			vs, err := c.TagsFollowed(ctx, pg)
+			if err != nil {
+				_ = yield(nil, err)
+				return
+			}
+
+			for _, v := range vs {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if *pg == zero {
+				return
+			}
+		}
 	}
-	return &tag, nil
 }
 
 // TagsFollowed returns a list of hashtags you follow.
-func (c *Client) TagsFollowed(ctx context.Context, pg *Pagination) ([]*FollowedTag, error) {
-	var hashtags []*FollowedTag
+func (c *Client) TagsFollowed(ctx context.Context, pg *Pagination) ([]*Tag, error) {
+	var hashtags []*Tag
 	err := c.doAPI(ctx, http.MethodGet, "/api/v1/followed_tags", nil, &hashtags, pg)
 	if err != nil {
 		return nil, err
@@ -293,3 +293,85 @@ func TestTagsFollowed(t *testing.T) {
 		t.Fatalf("want %v but %v", true, tags[0].Following)
 	}
 }
+
+func TestTagFeature(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/tags/test/feature":
+			fmt.Fprintln(w, `{"id": "1", "name": "test", "following": true, "featuring": true}`)
+		case "/api/v1/tags/test/unfeature":
+			fmt.Fprintln(w, `{"id": "1", "name": "test", "following": true, "featuring": false}`)
+		default:
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	_, err := client.TagFeature(context.Background(), "foo")
+	if err == nil {
+		t.Fatalf("should be fail: %v", err)
+	}
+	tag, err := client.TagFeature(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if !tag.Featuring || !tag.Following {
+		t.Fatalf("want %t, %t but %t, %t", true, true, tag.Featuring, tag.Following)
+	}
+	tag, err = client.TagUnfeature(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("should not be fail: %v", err)
+	}
+	if tag.Featuring {
+		t.Fatalf("want %t but %t", false, tag.Featuring)
+	}
+}
+
+func TestFollowedTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/followed_tags" {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		switch r.FormValue("max_id") {
+		case "":
+			w.Header().Set("Link", `<http://example.com?max_id=2&limit=2>; rel="next"`)
+			fmt.Fprintln(w, `[{"name": "foo", "following": true}, {"name": "bar", "following": true}]`)
+		case "2":
+			fmt.Fprintln(w, `[{"name": "baz", "following": true, "history": [{"day": "1668124800", "accounts": "3", "uses": "5"}]}]`)
+		default:
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(&Config{
+		Server:       ts.URL,
+		ClientID:     "foo",
+		ClientSecret: "bar",
+		AccessToken:  "zoo",
+	})
+	var tags []*Tag
+	for tag, err := range client.FollowedTags(context.Background(), &Pagination{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("should not be fail: %v", err)
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) != 3 {
+		t.Fatalf("result should be three: %d", len(tags))
+	}
+	if tags[2].Name != "baz" || tags[2].History[0].Uses != 5 || tags[2].History[0].Accounts != 3 {
+		t.Fatalf("want %q used %d times by %d accounts but %v", "baz", 5, 3, tags[2])
+	}
+}